@@ -0,0 +1,635 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/nauyey/guard/tags"
+)
+
+// Generate generates the Validate methods of the structs named types in the files of a package,
+// which are parsed with the file set fset. If types is empty, the Validate methods of all the
+// non-generic structs with guard tags and without Validate methods are generated.
+//
+// The package is type-checked, so the rules support the same field types as package tags.
+// The type errors of the package, like calls of the Validate methods to generate, are ignored.
+//
+// It returns the formatted source of the generated file.
+func Generate(fset *token.FileSet, files []*ast.File, types []string) ([]byte, error) {
+	g := &generator{
+		structs:     map[string]*ast.TypeSpec{},
+		hasValidate: map[string]bool{},
+		imports:     map[string]bool{"github.com/nauyey/guard": true},
+	}
+	g.collect(files)
+	g.check(fset, files)
+
+	targets, err := g.targets(types)
+	if err != nil {
+		return nil, err
+	}
+	g.validatable = map[string]bool{}
+	for name := range g.hasValidate {
+		g.validatable[name] = true
+	}
+	for _, name := range targets {
+		g.validatable[name] = true
+	}
+
+	for _, name := range targets {
+		if err := g.generate(g.structs[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by guardgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&src, "package %s\n\n", files[0].Name.Name)
+	fmt.Fprintf(&src, "import (\n")
+	var std, others []string
+	for path := range g.imports {
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			others = append(others, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(others)
+	for _, path := range std {
+		fmt.Fprintf(&src, "\t%q\n", path)
+	}
+	if len(std) != 0 {
+		fmt.Fprintf(&src, "\n")
+	}
+	for _, path := range others {
+		fmt.Fprintf(&src, "\t%q\n", path)
+	}
+	fmt.Fprintf(&src, ")\n")
+	src.Write(g.buf.Bytes())
+
+	return format.Source(src.Bytes())
+}
+
+type generator struct {
+	buf bytes.Buffer
+
+	order       []string
+	structs     map[string]*ast.TypeSpec
+	hasValidate map[string]bool
+	validatable map[string]bool
+	imports     map[string]bool
+
+	pkg  *types.Package
+	info *types.Info
+}
+
+// collect collects the struct types and the types with Validate methods of the files.
+func (g *generator) collect(files []*ast.File) {
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok {
+						if _, ok := ts.Type.(*ast.StructType); ok {
+							g.order = append(g.order, ts.Name.Name)
+							g.structs[ts.Name.Name] = ts
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				if decl.Recv != nil && decl.Name.Name == "Validate" && len(decl.Recv.List) == 1 {
+					if name, ok := typeName(decl.Recv.List[0].Type); ok {
+						g.hasValidate[name] = true
+					}
+				}
+			}
+		}
+	}
+}
+
+// check type-checks the package of the files. The types of the fields are resolved even if
+// the package has type errors.
+func (g *generator) check(fset *token.FileSet, files []*ast.File) {
+	g.info = &types.Info{Types: map[ast.Expr]types.TypeAndValue{}}
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(error) {},
+	}
+	g.pkg, _ = conf.Check(files[0].Name.Name, fset, files, g.info)
+}
+
+// qualifier qualifies the types of the other packages by their package names,
+// and records the imports of the packages.
+func (g *generator) qualifier(pkg *types.Package) string {
+	if pkg == g.pkg {
+		return ""
+	}
+	g.imports[pkg.Path()] = true
+	return pkg.Name()
+}
+
+// typeString returns the type expression of typ in the generated file.
+func (g *generator) typeString(typ types.Type) string {
+	return types.TypeString(typ, g.qualifier)
+}
+
+// targets returns the names of the structs to generate Validate methods.
+func (g *generator) targets(names []string) ([]string, error) {
+	if len(names) == 0 {
+		for _, name := range g.order {
+			ts := g.structs[name]
+			if !g.hasValidate[name] && ts.TypeParams == nil && (hasGuardTag(ts) || g.hasNestedValidation(name)) {
+				names = append(names, name)
+			}
+		}
+		return names, nil
+	}
+
+	for _, name := range names {
+		ts, ok := g.structs[name]
+		if !ok {
+			return nil, fmt.Errorf("struct %s not found", name)
+		}
+		if ts.TypeParams != nil {
+			return nil, fmt.Errorf("struct %s: generic structs aren't supported", name)
+		}
+		if g.hasValidate[name] {
+			return nil, fmt.Errorf("struct %s already has a Validate method", name)
+		}
+	}
+	return names, nil
+}
+
+// hasNestedValidation reports whether the struct name has fields validated by package tags.
+func (g *generator) hasNestedValidation(name string) bool {
+	obj := g.pkg.Scope().Lookup(name)
+	if obj == nil {
+		return false
+	}
+	st, ok := obj.Type().Underlying().(*types.Struct)
+	return ok && g.needsValidation(st, map[types.Type]bool{})
+}
+
+// item is a validator expression of the generated code, guarded by the condition cond if it isn't "".
+type item struct {
+	cond string
+	expr string
+}
+
+func (g *generator) generate(ts *ast.TypeSpec) error {
+	name := ts.Name.Name
+	recv := receiverName(name)
+
+	items := []item{}
+	for _, f := range ts.Type.(*ast.StructType).Fields.List {
+		fieldItems, err := g.fieldItems(recv, f)
+		if err != nil {
+			return fmt.Errorf("struct %s: %v", name, err)
+		}
+		items = append(items, fieldItems...)
+	}
+
+	fmt.Fprintf(&g.buf, "\n// Validate implements interface guard.Validator\n")
+	fmt.Fprintf(&g.buf, "func (%s *%s) Validate() error {\n", recv, name)
+	if len(items) == 0 {
+		fmt.Fprintf(&g.buf, "return guard.Validate()\n}\n")
+		return nil
+	}
+	if allUnconditional(items) {
+		fmt.Fprintf(&g.buf, "return guard.Validate(\n")
+		for _, it := range items {
+			fmt.Fprintf(&g.buf, "%s,\n", it.expr)
+		}
+		fmt.Fprintf(&g.buf, ")\n}\n")
+		return nil
+	}
+
+	fmt.Fprintf(&g.buf, "vs := []guard.Validator{}\n")
+	for i := 0; i < len(items); {
+		if cond := items[i].cond; cond != "" {
+			fmt.Fprintf(&g.buf, "if %s {\n", cond)
+			for ; i < len(items) && items[i].cond == cond; i++ {
+				fmt.Fprintf(&g.buf, "vs = append(vs, %s)\n", items[i].expr)
+			}
+			fmt.Fprintf(&g.buf, "}\n")
+			continue
+		}
+		fmt.Fprintf(&g.buf, "vs = append(vs,\n")
+		for ; i < len(items) && items[i].cond == ""; i++ {
+			fmt.Fprintf(&g.buf, "%s,\n", items[i].expr)
+		}
+		fmt.Fprintf(&g.buf, ")\n")
+	}
+	fmt.Fprintf(&g.buf, "return guard.Validate(vs...)\n}\n")
+	return nil
+}
+
+func (g *generator) fieldItems(recv string, f *ast.Field) ([]item, error) {
+	tag := ""
+	if f.Tag != nil {
+		tag, _ = strconv.Unquote(f.Tag.Value)
+	}
+	guardTag := reflect.StructTag(tag).Get("guard")
+	if guardTag == "-" {
+		return nil, nil
+	}
+	rules, err := tags.Parse(guardTag)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, n := range f.Names {
+		if n.IsExported() {
+			names = append(names, n.Name)
+		}
+	}
+	embedded := len(f.Names) == 0
+	if embedded {
+		name, ok := embeddedName(f.Type)
+		if !ok {
+			return nil, nil
+		}
+		names = append(names, name)
+	}
+
+	typ := g.info.TypeOf(f.Type)
+	if typ == nil || typ == types.Typ[types.Invalid] {
+		if len(rules) == 0 {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("field %s: type %s can't be resolved", strings.Join(names, ", "), types.ExprString(f.Type))
+	}
+
+	items := []item{}
+	for _, name := range names {
+		path, hasPath := jsonName(tag, name)
+		bind := func(expr string) string {
+			if embedded && !hasPath {
+				return expr
+			}
+			return fmt.Sprintf("guard.Field(%q, %s)", path, expr)
+		}
+
+		field := recv + "." + name
+		for _, r := range rules {
+			it, err := g.ruleItem(r, field, typ)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %v", name, err)
+			}
+			it.expr = bind(it.expr)
+			items = append(items, it)
+		}
+		expr, ok := g.nested(field, typ)
+		if ok {
+			items = append(items, item{expr: bind(expr)})
+		} else if g.needsValidation(typ, map[types.Type]bool{}) {
+			return nil, fmt.Errorf("field %s: type %s has guard tags but no Validate method, "+
+				"generate the Validate method of it, too", name, g.typeString(typ))
+		}
+	}
+	return items, nil
+}
+
+// ruleItem returns the validator of the rule r for the field of type typ.
+func (g *generator) ruleItem(r tags.Rule, field string, typ types.Type) (item, error) {
+	if r.Name == "notnil" {
+		if !nilable(typ) {
+			return item{}, fmt.Errorf("rule %q doesn't support type %s", r.Name, g.typeString(typ))
+		}
+		return item{expr: g.validator("NotNil", "Value", field)}, nil
+	}
+
+	it := item{}
+	value := field
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		it.cond = field + " != nil"
+		value = "*" + field
+		typ = ptr.Elem()
+	}
+
+	var err error
+	it.expr, err = g.ruleExpr(r, value, typ)
+	return it, err
+}
+
+func (g *generator) ruleExpr(r tags.Rule, value string, typ types.Type) (string, error) {
+	switch r.Name {
+	case "len":
+		return g.lenExpr(r, value, typ)
+	case "min", "max":
+		return g.boundExpr(r, value, typ)
+	}
+
+	str, err := g.stringValue(r, value, typ)
+	if err != nil {
+		return "", err
+	}
+	switch r.Name {
+	case "notblank":
+		return g.validator("StringNotBlank", "Value", str), nil
+	case "email":
+		return g.validator("Email", "Value", str), nil
+	case "url":
+		return g.validator("URL", "Value", str), nil
+	case "uuid":
+		return g.validator("UUID", "Value", str), nil
+	case "match":
+		return g.validator("StringMatches", "Value", str, "Pattern", quote(r.Param)), nil
+	case "in", "notin":
+		values := []string{}
+		for _, v := range r.Values() {
+			values = append(values, strconv.Quote(v))
+		}
+		kind := "StringInclusion"
+		if r.Name == "notin" {
+			kind = "StringExclusion"
+		}
+		return g.validator(kind, "Value", str, "In", "[]string{"+strings.Join(values, ", ")+"}"), nil
+	}
+	return "", fmt.Errorf("rule %q isn't supported", r.Name)
+}
+
+// stringValue returns the string expression of a value of type typ, whose underlying type must be string.
+func (g *generator) stringValue(r tags.Rule, value string, typ types.Type) (string, error) {
+	if basic, ok := typ.Underlying().(*types.Basic); !ok || basic.Info()&types.IsString == 0 {
+		return "", fmt.Errorf("rule %q doesn't support type %s", r.Name, g.typeString(typ))
+	}
+	if types.Identical(typ, types.Typ[types.String]) {
+		return value, nil
+	}
+	return "string(" + value + ")", nil
+}
+
+func (g *generator) lenExpr(r tags.Rule, value string, typ types.Type) (string, error) {
+	min, max := r.Bounds()
+
+	var elem types.Type
+	switch t := typ.Underlying().(type) {
+	case *types.Slice:
+		elem = t.Elem()
+	case *types.Array:
+		elem = t.Elem()
+		if strings.HasPrefix(value, "*") {
+			value = "(" + value + ")"
+		}
+		value += "[:]"
+	}
+	if elem != nil {
+		vs := []string{}
+		if min != "" {
+			vs = append(vs, g.validator("MinItems["+g.typeString(elem)+"]", "Values", value, "Min", min))
+		}
+		if max != "" {
+			vs = append(vs, g.validator("MaxItems["+g.typeString(elem)+"]", "Values", value, "Max", max))
+		}
+		if len(vs) == 1 {
+			return vs[0], nil
+		}
+		return "guard.AllOf(" + strings.Join(vs, ", ") + ")", nil
+	}
+
+	str, err := g.stringValue(r, value, typ)
+	if err != nil {
+		return "", err
+	}
+	if min == "" {
+		min = "0"
+	}
+	if max == "" {
+		g.imports["math"] = true
+		max = "math.MaxInt"
+	}
+	return g.validator("StringLength", "Value", str, "Min", min, "Max", max), nil
+}
+
+// boundExpr returns the validator of rule min or max. The bound must be a constant of type typ,
+// whose underlying type must be an integer or a float type.
+func (g *generator) boundExpr(r tags.Rule, value string, typ types.Type) (string, error) {
+	basic, ok := typ.Underlying().(*types.Basic)
+	if !ok || basic.Info()&(types.IsInteger|types.IsFloat) == 0 {
+		return "", fmt.Errorf("rule %q doesn't support type %s", r.Name, g.typeString(typ))
+	}
+
+	var target string
+	bitSize := bitSizes[basic.Kind()]
+	switch {
+	case basic.Info()&types.IsUnsigned != 0:
+		n, err := strconv.ParseUint(r.Param, 10, bitSize)
+		if err != nil {
+			return "", fmt.Errorf("rule %q: malformed unsigned integer %q of type %s", r.Name, r.Param, g.typeString(typ))
+		}
+		target = strconv.FormatUint(n, 10)
+	case basic.Info()&types.IsInteger != 0:
+		n, err := strconv.ParseInt(r.Param, 10, bitSize)
+		if err != nil {
+			return "", fmt.Errorf("rule %q: malformed integer %q of type %s", r.Name, r.Param, g.typeString(typ))
+		}
+		target = strconv.FormatInt(n, 10)
+	default:
+		f, err := strconv.ParseFloat(r.Param, bitSize)
+		if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+			return "", fmt.Errorf("rule %q: malformed number %q of type %s", r.Name, r.Param, g.typeString(typ))
+		}
+		target = strconv.FormatFloat(f, 'g', -1, bitSize)
+	}
+
+	kind := "GreaterThanOrEqualTo"
+	if r.Name == "max" {
+		kind = "LessThanOrEqualTo"
+	}
+	return g.validator(kind+"["+g.typeString(typ)+"]", "Value", value, "Target", target), nil
+}
+
+// bitSizes are the sizes of the basic integer and float types.
+// The sizes of int, uint and uintptr are the ones of 64-bit platforms.
+var bitSizes = map[types.BasicKind]int{
+	types.Int: 64, types.Int8: 8, types.Int16: 16, types.Int32: 32, types.Int64: 64,
+	types.Uint: 64, types.Uint8: 8, types.Uint16: 16, types.Uint32: 32, types.Uint64: 64, types.Uintptr: 64,
+	types.Float32: 32, types.Float64: 64,
+}
+
+// nilable reports whether the values of type typ may be nil, the same as the nilable kinds of package tags.
+func nilable(typ types.Type) bool {
+	switch t := typ.Underlying().(type) {
+	case *types.Chan, *types.Signature, *types.Interface, *types.Map, *types.Pointer, *types.Slice:
+		return true
+	case *types.Basic:
+		return t.Kind() == types.UnsafePointer
+	}
+	return false
+}
+
+// nested returns the validator of a field whose type has a Validate method, or a slice or an array of them.
+func (g *generator) nested(field string, typ types.Type) (string, bool) {
+	if g.isValidatable(typ) {
+		if nilable(typ) {
+			return "guard.AllowNil(" + field + ")", true
+		}
+		return "&" + field, true
+	}
+
+	var elem types.Type
+	switch t := typ.Underlying().(type) {
+	case *types.Slice:
+		elem = t.Elem()
+	case *types.Array:
+		elem = t.Elem()
+		field += "[:]"
+	}
+	if elem == nil || !g.isValidatable(elem) {
+		return "", false
+	}
+	ret := "&elem"
+	if nilable(elem) {
+		ret = "guard.AllowNil(elem)"
+	}
+	g.imports["github.com/nauyey/guard/validators"] = true
+	elemType := g.typeString(elem)
+	return fmt.Sprintf("&validators.Each[%s]{Values: %s, Validator: func(elem %s) guard.Validator { return %s }}", elemType, field, elemType, ret), true
+}
+
+// isValidatable reports whether the values of type typ, or the values pointed by typ, have Validate methods,
+// including the ones to generate.
+func (g *generator) isValidatable(typ types.Type) bool {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		if _, ok := ptr.Elem().Underlying().(*types.Interface); ok {
+			return false
+		}
+		typ = ptr.Elem()
+	}
+	if named, ok := typ.(*types.Named); ok && named.Obj().Pkg() == g.pkg && named.TypeArgs().Len() == 0 && g.validatable[named.Obj().Name()] {
+		return true
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(typ, true, g.pkg, "Validate")
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return false
+	}
+	sig := fn.Type().(*types.Signature)
+	return sig.Params().Len() == 0 && sig.Results().Len() == 1 &&
+		types.Identical(sig.Results().At(0).Type(), types.Universe.Lookup("error").Type())
+}
+
+// needsValidation reports whether the values of type typ are validated by package tags, which means
+// they have Validate methods, or they are structs with guard tags or nested validations, or slices,
+// arrays or pointers of them.
+func (g *generator) needsValidation(typ types.Type, seen map[types.Type]bool) bool {
+	if g.isValidatable(typ) {
+		return true
+	}
+	if seen[typ] {
+		return false
+	}
+	seen[typ] = true
+
+	switch t := typ.Underlying().(type) {
+	case *types.Pointer:
+		return g.needsValidation(t.Elem(), seen)
+	case *types.Slice:
+		return g.needsValidation(t.Elem(), seen)
+	case *types.Array:
+		return g.needsValidation(t.Elem(), seen)
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			f := t.Field(i)
+			tag, hasTag := reflect.StructTag(t.Tag(i)).Lookup("guard")
+			if tag == "-" || !f.Exported() && !f.Embedded() {
+				continue
+			}
+			if hasTag || g.needsValidation(f.Type(), seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// validator returns the expression of a built-in validator with the fields of key-value pairs.
+func (g *generator) validator(kind string, kvs ...string) string {
+	g.imports["github.com/nauyey/guard/validators"] = true
+
+	fields := make([]string, 0, len(kvs)/2)
+	for i := 0; i+1 < len(kvs); i += 2 {
+		fields = append(fields, kvs[i]+": "+kvs[i+1])
+	}
+	return "&validators." + kind + "{" + strings.Join(fields, ", ") + "}"
+}
+
+func allUnconditional(items []item) bool {
+	for _, it := range items {
+		if it.cond != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func hasGuardTag(ts *ast.TypeSpec) bool {
+	for _, f := range ts.Type.(*ast.StructType).Fields.List {
+		if f.Tag == nil {
+			continue
+		}
+		tag, _ := strconv.Unquote(f.Tag.Value)
+		if v, ok := reflect.StructTag(tag).Lookup("guard"); ok && v != "-" {
+			return true
+		}
+	}
+	return false
+}
+
+// embeddedName returns the field name of an embedded field of type T, *T, pkg.T or *pkg.T.
+func embeddedName(expr ast.Expr) (string, bool) {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if sel, ok := expr.(*ast.SelectorExpr); ok {
+		return sel.Sel.Name, true
+	}
+	return typeName(expr)
+}
+
+// typeName returns the name of a type expression T or *T.
+func typeName(expr ast.Expr) (string, bool) {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if id, ok := expr.(*ast.Ident); ok {
+		return id.Name, true
+	}
+	return "", false
+}
+
+// jsonName returns the name of the json tag, or the name of the field if there isn't one.
+func jsonName(tag, field string) (string, bool) {
+	name, _, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+	if name == "" || name == "-" {
+		return field, false
+	}
+	return name, true
+}
+
+func receiverName(typeName string) string {
+	return string(unicode.ToLower([]rune(typeName)[0]))
+}
+
+func quote(s string) string {
+	if strconv.CanBackquote(s) {
+		return "`" + s + "`"
+	}
+	return strconv.Quote(s)
+}
@@ -0,0 +1,250 @@
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func parseSource(t *testing.T, src string) (*token.FileSet, []*ast.File) {
+	t.Helper()
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "models.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("parser.ParseFile failed with err=%v", err)
+	}
+	return fset, []*ast.File{f}
+}
+
+func parse(t *testing.T, src string, types []string) (*token.FileSet, []*ast.File, []string) {
+	t.Helper()
+	fset, files := parseSource(t, src)
+	return fset, files, types
+}
+
+func TestGenerate(t *testing.T) {
+	src := `package models
+
+type Gender string
+
+type Author struct {
+	Name string ` + "`json:\"name\" guard:\"notblank\"`" + `
+}
+
+type Reviewer struct{}
+
+func (r *Reviewer) Validate() error { return nil }
+
+type Book struct {
+	Title     string      ` + "`json:\"title,omitempty\" guard:\"notblank,len=3..\"`" + `
+	Gender    Gender      ` + "`json:\"gender\" guard:\"in=female|male\"`" + `
+	Price     *float64    ` + "`json:\"price\" guard:\"min=0\"`" + `
+	Tags      []string    ` + "`json:\"tags\" guard:\"notnil,len=..2\"`" + `
+	Author    *Author     ` + "`json:\"author\" guard:\"notnil\"`" + `
+	Reviewers []Reviewer  ` + "`json:\"reviewers\"`" + `
+	Skipped   string      ` + "`guard:\"-\"`" + `
+	internal  string
+}
+`
+	want := `// Code generated by guardgen. DO NOT EDIT.
+
+package models
+
+import (
+	"math"
+
+	"github.com/nauyey/guard"
+	"github.com/nauyey/guard/validators"
+)
+
+// Validate implements interface guard.Validator
+func (a *Author) Validate() error {
+	return guard.Validate(
+		guard.Field("name", &validators.StringNotBlank{Value: a.Name}),
+	)
+}
+
+// Validate implements interface guard.Validator
+func (b *Book) Validate() error {
+	vs := []guard.Validator{}
+	vs = append(vs,
+		guard.Field("title", &validators.StringNotBlank{Value: b.Title}),
+		guard.Field("title", &validators.StringLength{Value: b.Title, Min: 3, Max: math.MaxInt}),
+		guard.Field("gender", &validators.StringInclusion{Value: string(b.Gender), In: []string{"female", "male"}}),
+	)
+	if b.Price != nil {
+		vs = append(vs, guard.Field("price", &validators.GreaterThanOrEqualTo[float64]{Value: *b.Price, Target: 0}))
+	}
+	vs = append(vs,
+		guard.Field("tags", &validators.NotNil{Value: b.Tags}),
+		guard.Field("tags", &validators.MaxItems[string]{Values: b.Tags, Max: 2}),
+		guard.Field("author", &validators.NotNil{Value: b.Author}),
+		guard.Field("author", guard.AllowNil(b.Author)),
+		guard.Field("reviewers", &validators.Each[Reviewer]{Values: b.Reviewers, Validator: func(elem Reviewer) guard.Validator { return &elem }}),
+	)
+	return guard.Validate(vs...)
+}
+`
+	got, err := Generate(parse(t, src, nil))
+	if err != nil {
+		t.Fatalf("Generate failed with err=%v", err)
+	}
+	if string(got) != want {
+		t.Errorf("Generate failed with source:\n%s\nwant source:\n%s", got, want)
+	}
+
+	// test generating the named structs
+	got, err = Generate(parse(t, src, []string{"Author"}))
+	if err != nil {
+		t.Fatalf("Generate failed with err=%v", err)
+	}
+	if !strings.Contains(string(got), "func (a *Author) Validate() error") || strings.Contains(string(got), "func (b *Book) Validate() error") {
+		t.Errorf("Generate failed with source:\n%s", got)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		src   string
+		types []string
+	}{
+		{"package models\ntype User struct {\n\tAge int `guard:\"notblank\"`\n}\n", nil},
+		{"package models\ntype User struct {\n\tAge int `guard:\"min=1.5\"`\n}\n", nil},
+		{"package models\ntype User struct {\n\tName string `guard:\"len=x\"`\n}\n", nil},
+		{"package models\ntype User struct {\n\tName string `guard:\"notnil\"`\n}\n", nil},
+		{"package models\ntype User struct {\n\tIDs [2]int `guard:\"notnil\"`\n}\n", nil},
+		{"package models\nimport \"time\"\ntype User struct {\n\tBorn time.Time `guard:\"notnil\"`\n}\n", nil},
+		{"package models\ntype Author struct{}\ntype User struct {\n\tAuthor Author `guard:\"notnil\"`\n}\n", nil},
+		{"package models\ntype Level string\ntype User struct {\n\tLevel Level `guard:\"min=1\"`\n}\n", nil},
+		{"package models\nimport \"time\"\ntype User struct {\n\tBorn time.Time `guard:\"min=1\"`\n}\n", nil},
+		{"package models\ntype User struct {\n\tAge uint `guard:\"min=-1\"`\n}\n", nil},
+		{"package models\ntype User struct {\n\tAge int8 `guard:\"max=1000\"`\n}\n", nil},
+		{"package models\ntype User struct {\n\tScore float64 `guard:\"max=Inf\"`\n}\n", nil},
+		{"package models\ntype User struct {\n\tName Unknown `guard:\"notblank\"`\n}\n", nil},
+		{"package models\ntype User[T any] struct {\n\tName string `guard:\"notblank\"`\n}\n", []string{"User"}},
+		{"package models\ntype User struct{}\n", []string{"Book"}},
+		{"package models\ntype User struct{}\nfunc (u User) Validate() error { return nil }\n", []string{"User"}},
+	}
+	for _, test := range tests {
+		if _, err := Generate(parse(t, test.src, test.types)); err == nil {
+			t.Errorf("Generate failed to return err with source:\n%s", test.src)
+		}
+	}
+}
+
+func TestGenerateTypes(t *testing.T) {
+	src := `package models
+
+import (
+	"net/http"
+	tm "time"
+)
+
+type Level int
+
+type Code string
+
+type Page[T any] struct {
+	Items []T ` + "`json:\"items\" guard:\"len=..100\"`" + `
+}
+
+type Task struct {
+	Timeout  tm.Duration     ` + "`json:\"timeout\" guard:\"min=1,max=3600000000000\"`" + `
+	Month    *tm.Month       ` + "`json:\"month\" guard:\"min=1,max=12\"`" + `
+	Level    Level           ` + "`json:\"level\" guard:\"max=9\"`" + `
+	Retries  uint8           ` + "`json:\"retries\" guard:\"max=255\"`" + `
+	Initial  rune            ` + "`json:\"initial\" guard:\"min=65\"`" + `
+	Flag     byte            ` + "`json:\"flag\" guard:\"max=1\"`" + `
+	Ratio    float32         ` + "`json:\"ratio\" guard:\"min=0.5\"`" + `
+	Code     Code            ` + "`json:\"code\" guard:\"in=a|b\"`" + `
+	Method   *string         ` + "`json:\"method\" guard:\"notnil,len=1..\"`" + `
+	Slots    *[3]tm.Time     ` + "`json:\"slots\" guard:\"len=1..\"`" + `
+	Dates    []tm.Time       ` + "`json:\"dates\" guard:\"notnil,len=..10\"`" + `
+	Header   http.Header     ` + "`json:\"header\" guard:\"notnil\"`" + `
+	Client   *http.Client    ` + "`json:\"client\" guard:\"notnil\"`" + `
+	Handler  http.Handler    ` + "`json:\"handler\" guard:\"notnil\"`" + `
+	Done     chan struct{}   ` + "`json:\"done\" guard:\"notnil\"`" + `
+	Callback func()          ` + "`json:\"callback\" guard:\"notnil\"`" + `
+}
+`
+	fset, files := parseSource(t, src)
+	got, err := Generate(fset, files, nil)
+	if err != nil {
+		t.Fatalf("Generate failed with err=%v", err)
+	}
+	if strings.Contains(string(got), "Page") {
+		t.Errorf("Generate failed to skip the generic struct with source:\n%s", got)
+	}
+
+	typeCheck(t, fset, files, got)
+}
+
+// typeCheck type-checks the generated file with the source files.
+func typeCheck(t *testing.T, fset *token.FileSet, files []*ast.File, generated []byte) {
+	t.Helper()
+	gen, err := parser.ParseFile(fset, "guard_gen.go", generated, 0)
+	if err != nil {
+		t.Fatalf("Generate failed with source:\n%s\nerr=%v", generated, err)
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := conf.Check("models", fset, append(files, gen), nil); err != nil {
+		t.Errorf("Generate failed with source:\n%s\nerr=%v", generated, err)
+	}
+}
+
+func TestGenerateNested(t *testing.T) {
+	src := `package models
+
+type Checker interface {
+	Validate() error
+}
+
+type Deep struct {
+	Name string ` + "`json:\"name\" guard:\"notblank\"`" + `
+}
+
+type Inner struct {
+	Deep  Deep     ` + "`json:\"deep\"`" + `
+	Deeps [2]*Deep ` + "`json:\"deeps\"`" + `
+}
+
+type User struct {
+	Inner   Inner     ` + "`json:\"inner\"`" + `
+	Checker Checker   ` + "`json:\"checker\"`" + `
+	Checks  []Checker ` + "`json:\"checks\"`" + `
+	Plain   struct{ Name string }
+}
+`
+	fset, files := parseSource(t, src)
+	got, err := Generate(fset, files, nil)
+	if err != nil {
+		t.Fatalf("Generate failed with err=%v", err)
+	}
+	for _, want := range []string{
+		"func (d *Deep) Validate() error",
+		"func (i *Inner) Validate() error",
+		`guard.Field("deep", &i.Deep)`,
+		`guard.Field("deeps", &validators.Each[*Deep]{Values: i.Deeps[:], Validator: func(elem *Deep) guard.Validator { return guard.AllowNil(elem) }})`,
+		`guard.Field("inner", &u.Inner)`,
+		`guard.Field("checker", guard.AllowNil(u.Checker))`,
+		`guard.Field("checks", &validators.Each[Checker]{Values: u.Checks, Validator: func(elem Checker) guard.Validator { return guard.AllowNil(elem) }})`,
+	} {
+		if !strings.Contains(string(got), want) {
+			t.Errorf("Generate failed with source:\n%s\nwant: %s", got, want)
+		}
+	}
+	if strings.Contains(string(got), "Plain") {
+		t.Errorf("Generate failed with source:\n%s", got)
+	}
+	typeCheck(t, fset, files, got)
+
+	// test failing on the nested structs whose Validate methods aren't generated
+	fset, files = parseSource(t, src)
+	if _, err := Generate(fset, files, []string{"User"}); err == nil || !strings.Contains(err.Error(), "Inner") {
+		t.Errorf("Generate failed with err=%v", err)
+	}
+}
@@ -0,0 +1,121 @@
+// Guardgen generates Validate methods from the guard tags of struct fields.
+//
+// It reads the same tags as package tags, like
+//
+//	type User struct {
+//		Name   string `json:"name" guard:"notblank,len=3..20"`
+//		Gender string `json:"gender" guard:"in=female|male|other"`
+//	}
+//
+// but writes the validators into Go code, so the validations keep static type checking
+// and don't use reflection at runtime. Fields whose types have Validate methods, including the
+// generated ones, and slices and arrays of them, are validated by their Validate methods, too.
+//
+// Package tags validates all the nested structs, so the nested structs with guard tags, or
+// with nested validations, must have Validate methods. Without -type, their Validate methods
+// are generated, too. Otherwise guardgen fails, instead of dropping their validations.
+//
+// Usage:
+//
+//	//go:generate guardgen -type User,Book
+//
+// Flags:
+//
+//	-type    comma-separated names of the structs, default all the non-generic structs
+//	         with guard tags or nested validations, and without Validate methods
+//	-output  output file name, default guard_gen.go in the package directory
+//
+// The argument is the package directory, default the current directory.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var (
+	typeNames = flag.String("type", "", "comma-separated names of the structs; default all the structs with guard tags")
+	output    = flag.String("output", "", "output file name; default guard_gen.go in the package directory")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage of guardgen:\n")
+	fmt.Fprintf(os.Stderr, "\tguardgen [flags] [directory]\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	dir := "."
+	if flag.NArg() > 1 {
+		usage()
+		os.Exit(2)
+	}
+	if flag.NArg() == 1 {
+		dir = flag.Arg(0)
+	}
+
+	outName := *output
+	if outName == "" {
+		outName = filepath.Join(dir, "guard_gen.go")
+	}
+
+	if err := run(dir, outName, *typeNames); err != nil {
+		fmt.Fprintf(os.Stderr, "guardgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir, outName, typeNames string) error {
+	fset, files, err := parseDir(dir, outName)
+	if err != nil {
+		return err
+	}
+
+	var types []string
+	if typeNames != "" {
+		types = strings.Split(typeNames, ",")
+	}
+	src, err := Generate(fset, files, types)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(outName, src, 0644)
+}
+
+// parseDir parses the Go files of the package in dir, except the tests and the output file.
+// It returns the file set of the parsed files, too.
+func parseDir(dir, outName string) (*token.FileSet, []*ast.File, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Strings(names)
+
+	fset := token.NewFileSet()
+	files := []*ast.File{}
+	for _, name := range names {
+		if strings.HasSuffix(name, "_test.go") || filepath.Clean(name) == filepath.Clean(outName) {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, nil, parser.ParseComments)
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, f)
+	}
+	if len(files) == 0 {
+		return nil, nil, fmt.Errorf("no Go files in %s", dir)
+	}
+	return fset, files, nil
+}
@@ -0,0 +1,197 @@
+package guard
+
+import "context"
+
+// combinator validation error messages
+const (
+	anyOfMsg     = "should satisfy any of the rules"
+	oneOfNoneMsg = "should satisfy one of the rules"
+	oneOfManyMsg = "should satisfy only one of the rules"
+)
+
+// combinator validation error codes
+const (
+	CodeAnyOf     = "any_of.none_valid"
+	CodeOneOfNone = "one_of.none_valid"
+	CodeOneOfMany = "one_of.many_valid"
+	CodeNot       = "not.valid"
+)
+
+// AnyOf returns a validator which is valid if any of the validators is valid.
+//
+// The validators are executed one by one until a valid one. If all of them are invalid,
+// their validation errors are grouped into a single validation error with code CodeAnyOf,
+// which implements interface GroupError and returns the validation errors of the validators.
+// If a validator faild by internal error, the error is returned immediately.
+func AnyOf(validators ...Validator) Validator {
+	return &anyOfValidator{validators: validators}
+}
+
+// OneOf returns a validator which is valid if exactly one of the validators is valid.
+//
+// If none of the validators is valid, their validation errors are grouped into
+// a single validation error with code CodeOneOfNone, the same as AnyOf.
+// If more than one is valid, the validation error has code CodeOneOfMany
+// and the indexes of the valid validators as parameter "valid".
+func OneOf(validators ...Validator) Validator {
+	return &oneOfValidator{validators: validators}
+}
+
+// AllOf returns a validator which is valid if all of the validators are valid.
+//
+// It groups the validators into one validator, which works the same as
+// guard.ValidateContext does. So a strict validator in the group only stops the rest of the group.
+func AllOf(validators ...Validator) Validator {
+	return &allOfValidator{validators: validators}
+}
+
+// Not returns a validator which inverts the validator v.
+//
+// If v is valid, a validation error with the message msg and code CodeNot is returned.
+// The message msg is kept by package i18n, like the messages set by OverrideMessage.
+// If v is invalid, the returned validator is valid.
+// If v faild by internal error, the error is returned.
+func Not(v Validator, msg string) Validator {
+	return &notValidator{validator: v, msg: msg}
+}
+
+type anyOfValidator struct {
+	validators []Validator
+}
+
+// Validate implements the Validator interface
+func (v *anyOfValidator) Validate() error {
+	return v.ValidateContext(context.Background())
+}
+
+// ValidateContext implements the ContextValidator interface
+func (v *anyOfValidator) ValidateContext(ctx context.Context) error {
+	errs := []error{}
+	for _, v := range v.validators {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		err := validate(ctx, v)
+		if err == nil {
+			return nil
+		}
+		var iErr error
+		if errs, iErr = collect(errs, err); iErr != nil {
+			return iErr
+		}
+	}
+
+	return &groupError{msg: anyOfMsg, code: CodeAnyOf, errs: errs}
+}
+
+type oneOfValidator struct {
+	validators []Validator
+}
+
+// Validate implements the Validator interface
+func (v *oneOfValidator) Validate() error {
+	return v.ValidateContext(context.Background())
+}
+
+// ValidateContext implements the ContextValidator interface
+func (v *oneOfValidator) ValidateContext(ctx context.Context) error {
+	errs := []error{}
+	valid := []int{}
+	for i, v := range v.validators {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		err := validate(ctx, v)
+		if err == nil {
+			valid = append(valid, i)
+			continue
+		}
+		var iErr error
+		if errs, iErr = collect(errs, err); iErr != nil {
+			return iErr
+		}
+	}
+
+	switch {
+	case len(valid) == 0:
+		return &groupError{msg: oneOfNoneMsg, code: CodeOneOfNone, errs: errs}
+	case len(valid) > 1:
+		return &groupError{msg: oneOfManyMsg, code: CodeOneOfMany, params: map[string]interface{}{"valid": valid}}
+	}
+	return nil
+}
+
+type allOfValidator struct {
+	validators []Validator
+}
+
+// Validate implements the Validator interface
+func (v *allOfValidator) Validate() error {
+	return v.ValidateContext(context.Background())
+}
+
+// ValidateContext implements the ContextValidator interface
+func (v *allOfValidator) ValidateContext(ctx context.Context) error {
+	return ValidateContext(ctx, v.validators...)
+}
+
+type notValidator struct {
+	validator Validator
+	msg       string
+}
+
+// Validate implements the Validator interface
+func (v *notValidator) Validate() error {
+	return v.ValidateContext(context.Background())
+}
+
+// ValidateContext implements the ContextValidator interface
+func (v *notValidator) ValidateContext(ctx context.Context) error {
+	err := validate(ctx, v.validator)
+	if err == nil {
+		return &groupError{msg: v.msg, code: CodeNot, overridden: v.msg != ""}
+	}
+	if _, iErr := collect(nil, err); iErr != nil {
+		return iErr
+	}
+	return nil
+}
+
+// groupError is a validation error which groups the validation errors of combined validators.
+type groupError struct {
+	msg    string
+	code   string
+	params map[string]interface{}
+	errs   []error
+
+	// overridden is true if msg is set by the caller, like the message of Not
+	overridden bool
+}
+
+// Error implements the error interface
+func (err *groupError) Error() string {
+	return err.msg
+}
+
+// ValidationError implements the Error interface
+func (err *groupError) ValidationError() {}
+
+// Overridden reports whether the message is set by the caller, so that it isn't translated
+func (err *groupError) Overridden() bool {
+	return err.overridden
+}
+
+// Code implements the CodedError interface
+func (err *groupError) Code() string {
+	return err.code
+}
+
+// Params implements the CodedError interface
+func (err *groupError) Params() map[string]interface{} {
+	return err.params
+}
+
+// GroupedErrors implements the GroupError interface
+func (err *groupError) GroupedErrors() []error {
+	return err.errs
+}
@@ -0,0 +1,137 @@
+package guard_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/nauyey/guard"
+)
+
+func codeOf(err error) string {
+	if cErr, ok := err.(guard.CodedError); ok {
+		return cErr.Code()
+	}
+	return ""
+}
+
+func TestAnyOf(t *testing.T) {
+	valid := &testValidator{}
+	phone := &testValidator{err: &validationError{msg: "phone"}}
+	email := &testValidator{err: &validationErrors{errs: []error{&validationError{msg: "email"}}}}
+
+	if err := guard.Validate(guard.AnyOf(phone, valid, email)); err != nil {
+		t.Errorf("guard.AnyOf failed with err=%v", err)
+	}
+
+	err := guard.Validate(guard.Field("contact", guard.AnyOf(phone, email)))
+	errs, ok := err.(guard.Errors)
+	if !ok || len(errs.ValidationErrors()) != 1 {
+		t.Fatalf("guard.AnyOf failed with err=%v", err)
+	}
+	gErr := errs.ValidationErrors()[0]
+	if fieldOf(gErr) != "contact" {
+		t.Errorf("guard.AnyOf failed with field=%q, want field=%q", fieldOf(gErr), "contact")
+	}
+	var cErr guard.CodedError
+	if !errors.As(gErr, &cErr) || cErr.Code() != guard.CodeAnyOf {
+		t.Fatalf("guard.AnyOf failed with err=%v", gErr)
+	}
+	children := cErr.(guard.GroupError).GroupedErrors()
+	if len(children) != 2 || children[0].Error() != "phone" || children[1].Error() != "email" {
+		t.Errorf("guard.AnyOf failed with children=%v", children)
+	}
+
+	// test with field-bound validators
+	err = guard.Validate(guard.Field("contact", guard.AnyOf(guard.Field("phone", phone), guard.Field("email", email))))
+	errs, ok = err.(guard.Errors)
+	if !ok || len(errs.ValidationErrors()) != 1 || fieldOf(errs.ValidationErrors()[0]) != "contact" {
+		t.Fatalf("guard.AnyOf failed with err=%v", err)
+	}
+	var fErr guard.FieldError
+	if !errors.As(errs.ValidationErrors()[0], &fErr) || fErr.Field() != "contact" {
+		t.Errorf("guard.AnyOf failed with err=%v", err)
+	}
+	err = guard.Validate(guard.AnyOf(guard.Field("phone", phone), guard.Field("email", email)))
+	errs, ok = err.(guard.Errors)
+	if !ok || len(errs.ValidationErrors()) != 1 || errors.As(errs.ValidationErrors()[0], &fErr) {
+		t.Errorf("guard.AnyOf failed with err=%v", err)
+	}
+	gErr = errs.ValidationErrors()[0]
+	if children := gErr.(guard.GroupError).GroupedErrors(); len(children) != 2 || fieldOf(children[0]) != "phone" {
+		t.Errorf("guard.AnyOf failed with children=%v", children)
+	}
+
+	// test with internal error
+	iErr := errors.New("internal error")
+	if err := guard.Validate(guard.AnyOf(phone, &testValidator{err: iErr}, valid)); err != iErr {
+		t.Errorf("guard.AnyOf failed with err=%v, want err=%v", err, iErr)
+	}
+
+	// test with strict and allow nil validators
+	var nilValidator *testValidator
+	if err := guard.Validate(guard.AnyOf(phone, guard.AllowNil(nilValidator))); err != nil {
+		t.Errorf("guard.AnyOf failed with err=%v", err)
+	}
+	next := &testContextValidator{}
+	err = guard.Validate(guard.Strict(guard.AnyOf(phone, email)), next)
+	if err == nil || next.ctx != nil {
+		t.Errorf("guard.AnyOf failed to stop by strict validator")
+	}
+}
+
+func TestOneOf(t *testing.T) {
+	valid := &testValidator{}
+	invalid := &testValidator{err: &validationError{}}
+
+	if err := guard.OneOf(invalid, valid, invalid).Validate(); err != nil {
+		t.Errorf("guard.OneOf failed with err=%v", err)
+	}
+	if err := guard.OneOf(invalid, invalid).Validate(); codeOf(err) != guard.CodeOneOfNone {
+		t.Errorf("guard.OneOf failed with err=%v", err)
+	}
+	err := guard.OneOf(valid, invalid, valid).Validate()
+	if codeOf(err) != guard.CodeOneOfMany || !reflect.DeepEqual(err.(guard.CodedError).Params()["valid"], []int{0, 2}) {
+		t.Errorf("guard.OneOf failed with err=%v", err)
+	}
+}
+
+func TestAllOf(t *testing.T) {
+	invalid := &testValidator{err: &validationError{}}
+
+	if err := guard.Validate(guard.AllOf(&testValidator{}, &testValidator{})); err != nil {
+		t.Errorf("guard.AllOf failed with err=%v", err)
+	}
+	err := guard.Validate(guard.Field("name", guard.AllOf(invalid, &testValidator{}, invalid)))
+	errs, ok := err.(guard.Errors)
+	if !ok || len(errs.ValidationErrors()) != 2 || fieldOf(errs.ValidationErrors()[1]) != "name" {
+		t.Errorf("guard.AllOf failed with err=%v", err)
+	}
+
+	// test nested in AnyOf
+	if err := guard.Validate(guard.AnyOf(guard.AllOf(invalid, &testValidator{}), &testValidator{})); err != nil {
+		t.Errorf("guard.AllOf failed with err=%v", err)
+	}
+}
+
+func TestNot(t *testing.T) {
+	if err := guard.Not(&testValidator{err: &validationError{}}, "shouldn't match").Validate(); err != nil {
+		t.Errorf("guard.Not failed with err=%v", err)
+	}
+	err := guard.Not(&testValidator{}, "shouldn't match").Validate()
+	if err == nil || err.Error() != "shouldn't match" || codeOf(err) != guard.CodeNot {
+		t.Errorf("guard.Not failed with err=%v", err)
+	}
+	iErr := errors.New("internal error")
+	if err := guard.Not(&testValidator{err: iErr}, "").Validate(); err != iErr {
+		t.Errorf("guard.Not failed with err=%v, want err=%v", err, iErr)
+	}
+
+	// test with canceled ctx
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := guard.ValidateContext(ctx, guard.Not(&testValidator{}, "")); err != context.Canceled {
+		t.Errorf("guard.Not failed with err=%v, want err=%v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,56 @@
+package guard
+
+import "context"
+
+// If returns a conditional validator which executes the validators only if cond is true.
+//
+// The validators are declared when If is called, but executed lazily by the
+// conditional validator. Use Else to declare the validators executed if cond is false.
+func If(cond bool, validators ...Validator) *Conditional {
+	return When(func() bool { return cond }, validators...)
+}
+
+// Unless returns a conditional validator which executes the validators only if cond is false.
+func Unless(cond bool, validators ...Validator) *Conditional {
+	return When(func() bool { return !cond }, validators...)
+}
+
+// When returns a conditional validator which executes the validators only if pred returns true.
+//
+// pred is called at validation time, every time the conditional validator is validated.
+func When(pred func() bool, validators ...Validator) *Conditional {
+	return &Conditional{
+		pred: pred,
+		then: validators,
+	}
+}
+
+// Conditional is a validator which executes one of two groups of validators by a condition.
+//
+// The selected validators are executed the same as guard.ValidateContext does,
+// so a strict validator in the group only stops the rest of the group.
+// Wrap the conditional validator by Strict to stop the outer validation, too.
+type Conditional struct {
+	pred      func() bool
+	then      []Validator
+	otherwise []Validator
+}
+
+// Else declares the validators executed if the condition is false.
+func (v *Conditional) Else(validators ...Validator) *Conditional {
+	v.otherwise = validators
+	return v
+}
+
+// Validate implements the Validator interface
+func (v *Conditional) Validate() error {
+	return v.ValidateContext(context.Background())
+}
+
+// ValidateContext implements the ContextValidator interface
+func (v *Conditional) ValidateContext(ctx context.Context) error {
+	if v.pred() {
+		return ValidateContext(ctx, v.then...)
+	}
+	return ValidateContext(ctx, v.otherwise...)
+}
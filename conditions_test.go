@@ -0,0 +1,88 @@
+package guard_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nauyey/guard"
+)
+
+func TestIf(t *testing.T) {
+	invalid := &testValidator{err: &validationError{msg: "then"}}
+	otherwise := &testValidator{err: &validationError{msg: "else"}}
+
+	if err := guard.Validate(guard.If(false, invalid)); err != nil {
+		t.Errorf("guard.If failed with err=%v", err)
+	}
+
+	err := guard.Validate(guard.If(true, invalid))
+	if errs, ok := err.(guard.Errors); !ok || len(errs.ValidationErrors()) != 1 || errs.ValidationErrors()[0].Error() != "then" {
+		t.Errorf("guard.If failed with err=%v", err)
+	}
+
+	// test else branch
+	err = guard.Validate(guard.If(false, invalid).Else(otherwise))
+	if errs, ok := err.(guard.Errors); !ok || len(errs.ValidationErrors()) != 1 || errs.ValidationErrors()[0].Error() != "else" {
+		t.Errorf("guard.If failed with err=%v", err)
+	}
+	if err := guard.Validate(guard.If(true, &testValidator{}).Else(otherwise)); err != nil {
+		t.Errorf("guard.If failed with err=%v", err)
+	}
+}
+
+func TestUnless(t *testing.T) {
+	invalid := &testValidator{err: &validationError{}}
+
+	if err := guard.Validate(guard.Unless(true, invalid)); err != nil {
+		t.Errorf("guard.Unless failed with err=%v", err)
+	}
+	if err := guard.Validate(guard.Unless(false, invalid)); err == nil {
+		t.Errorf("guard.Unless failed with err=nil")
+	}
+	if err := guard.Validate(guard.Unless(true, &testValidator{}).Else(invalid)); err == nil {
+		t.Errorf("guard.Unless failed with err=nil")
+	}
+}
+
+func TestWhen(t *testing.T) {
+	business := false
+	calls := 0
+	v := guard.When(func() bool {
+		calls++
+		return business
+	}, &testValidator{err: &validationError{}})
+
+	// test predicate evaluated lazily
+	if calls != 0 {
+		t.Errorf("guard.When failed to evaluate the predicate lazily")
+	}
+	if err := guard.Validate(v); err != nil {
+		t.Errorf("guard.When failed with err=%v", err)
+	}
+	business = true
+	if err := guard.Validate(v); err == nil {
+		t.Errorf("guard.When failed with err=nil")
+	}
+	if calls != 2 {
+		t.Errorf("guard.When failed with calls=%d, want calls=2", calls)
+	}
+
+	// test passing ctx down and binding field paths
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "value")
+	cv := &testContextValidator{err: &validationError{}}
+	err := guard.ValidateContext(ctx, guard.Field("company", guard.When(func() bool { return business }, cv)))
+	if cv.ctx == nil || cv.ctx.Value(key{}) != "value" {
+		t.Errorf("guard.When failed to pass ctx down")
+	}
+	if errs, ok := err.(guard.Errors); !ok || fieldOf(errs.ValidationErrors()[0]) != "company" {
+		t.Errorf("guard.When failed with err=%v", err)
+	}
+
+	// test with strict conditional validator
+	next := &testContextValidator{}
+	err = guard.Validate(guard.Strict(guard.If(true, &testValidator{err: &validationError{}})), next)
+	if err == nil || next.ctx != nil {
+		t.Errorf("guard.When failed to stop by strict conditional validator")
+	}
+}
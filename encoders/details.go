@@ -0,0 +1,158 @@
+// Package encoders encodes validation errors into standard wire formats,
+// like RFC 7807 problem details, JSON:API error objects and GraphQL errors.
+package encoders
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/nauyey/guard"
+)
+
+// Detail describes a validation error.
+//
+// Field is the field path of the validation error if it implements interface guard.FieldError.
+// Code and Params are set if the validation error implements interface guard.CodedError.
+// The non-finite float params are replaced by the strings "+Inf", "-Inf" and "NaN",
+// so the details can always be encoded in JSON.
+//
+// Errors are the details of the grouped validation errors if the validation error implements
+// interface guard.GroupError, like the errors of guard.AnyOf and guard.OneOf.
+// Their field paths are joined to the field path of the group, like "contact.phone".
+type Detail struct {
+	Message string                 `json:"message"`
+	Field   string                 `json:"field,omitempty"`
+	Code    string                 `json:"code,omitempty"`
+	Params  map[string]interface{} `json:"params,omitempty"`
+	Errors  []Detail               `json:"errors,omitempty"`
+}
+
+// Details returns the details of the validation errors of err.
+//
+// If err implements interface guard.Errors, every validation error of it is described.
+// If err isn't a validation error, Details returns nil.
+func Details(err error) []Detail {
+	switch vErr := err.(type) {
+	default:
+		return nil
+	case guard.Errors:
+		details := make([]Detail, 0, len(vErr.ValidationErrors()))
+		for _, e := range vErr.ValidationErrors() {
+			details = append(details, describe("", e))
+		}
+		return details
+	case guard.Error:
+		return []Detail{describe("", err)}
+	}
+}
+
+// describe describes err, whose field path is relative to the field path parent.
+func describe(parent string, err error) Detail {
+	d := Detail{Message: err.Error(), Field: parent}
+
+	var fErr guard.FieldError
+	if errors.As(err, &fErr) {
+		d.Field = joinPath(parent, fErr.Field())
+	}
+	var cErr guard.CodedError
+	if errors.As(err, &cErr) {
+		d.Code = cErr.Code()
+		d.Params = encodableParams(cErr.Params())
+	}
+	var gErr guard.GroupError
+	if errors.As(err, &gErr) {
+		for _, e := range gErr.GroupedErrors() {
+			d.Errors = append(d.Errors, describe(d.Field, e))
+		}
+	}
+	return d
+}
+
+// joinPath joins the field path child to its parent, like "contact" and "phone" to "contact.phone".
+func joinPath(parent, child string) string {
+	switch {
+	case parent == "":
+		return child
+	case child == "":
+		return parent
+	case strings.HasPrefix(child, "["):
+		return parent + child
+	}
+	return parent + "." + child
+}
+
+// encodableParams returns params whose non-finite floats are replaced by "+Inf", "-Inf" and "NaN",
+// which encoding/json can't encode. params isn't modified.
+func encodableParams(params map[string]interface{}) map[string]interface{} {
+	var encodable map[string]interface{}
+	for k, v := range params {
+		s, ok := nonFinite(v)
+		if !ok {
+			continue
+		}
+		if encodable == nil {
+			encodable = make(map[string]interface{}, len(params))
+			for k, v := range params {
+				encodable[k] = v
+			}
+		}
+		encodable[k] = s
+	}
+	if encodable == nil {
+		return params
+	}
+	return encodable
+}
+
+// nonFinite returns the string of v if it's a ±Inf or NaN float.
+func nonFinite(v interface{}) (string, bool) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Float32 && rv.Kind() != reflect.Float64 {
+		return "", false
+	}
+	f := rv.Float()
+	if !math.IsInf(f, 0) && !math.IsNaN(f) {
+		return "", false
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64), true
+}
+
+// splitPath splits a field path like `items[3].labels["app.kubernetes.io/name"]` into its segments
+// "items", 3, "labels" and "app.kubernetes.io/name". Indexes are ints, and field names and map keys are strings.
+func splitPath(path string) []interface{} {
+	segments := []interface{}{}
+	for path != "" {
+		switch {
+		case path[0] == '.':
+			path = path[1:]
+			continue
+		case strings.HasPrefix(path, `["`):
+			if quoted, err := strconv.QuotedPrefix(path[1:]); err == nil && strings.HasPrefix(path[1+len(quoted):], "]") {
+				key, _ := strconv.Unquote(quoted)
+				segments = append(segments, key)
+				path = path[len(quoted)+2:]
+				continue
+			}
+		case path[0] == '[':
+			if end := strings.IndexByte(path, ']'); end > 0 {
+				if i, err := strconv.Atoi(path[1:end]); err == nil {
+					segments = append(segments, i)
+					path = path[end+1:]
+					continue
+				}
+			}
+		}
+
+		// a field name ends before the next segment
+		end := strings.IndexAny(path[1:], ".[") + 1
+		if end == 0 {
+			end = len(path)
+		}
+		segments = append(segments, path[:end])
+		path = path[end:]
+	}
+	return segments
+}
@@ -0,0 +1,100 @@
+package encoders_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/nauyey/guard"
+	"github.com/nauyey/guard/encoders"
+	"github.com/nauyey/guard/validators"
+)
+
+func testErrors() error {
+	return guard.Validate(
+		guard.Field("items", guard.Index(3, guard.Field("price", &validators.IntGreaterThan{Value: 0, Target: 0}))),
+		&validators.StringNotBlank{},
+	)
+}
+
+// rangeError is a coded validation error with non-finite params.
+type rangeError struct{}
+
+func (err *rangeError) Error() string    { return "should be in range" }
+func (err *rangeError) ValidationError() {}
+func (err *rangeError) Code() string     { return "range" }
+func (err *rangeError) Params() map[string]interface{} {
+	return map[string]interface{}{"min": 0, "max": math.Inf(1), "low": float32(math.Inf(-1)), "mid": math.NaN()}
+}
+
+type rangeValidator struct{}
+
+func (v *rangeValidator) Validate() error { return &rangeError{} }
+
+func nonFiniteErrors() error {
+	return guard.Validate(guard.Field("price", &rangeValidator{}))
+}
+
+func TestDetails(t *testing.T) {
+	details := encoders.Details(testErrors())
+	want := []encoders.Detail{
+		{
+			Message: "should be great than",
+			Field:   "items[3].price",
+			Code:    validators.CodeNotGreaterThan,
+			Params:  map[string]interface{}{"target": 0},
+		},
+		{
+			Message: "shouldn't be blank",
+			Code:    validators.CodeStringBlank,
+		},
+	}
+	if !reflect.DeepEqual(details, want) {
+		t.Errorf("encoders.Details failed with details=%v, want details=%v", details, want)
+	}
+
+	// test with non-validation errors
+	if details := encoders.Details(errors.New("non-validation error")); details != nil {
+		t.Errorf("encoders.Details failed with details=%v, want details=nil", details)
+	}
+	if details := encoders.Details(nil); details != nil {
+		t.Errorf("encoders.Details failed with details=%v, want details=nil", details)
+	}
+}
+
+func TestDetailsWithNonFiniteParams(t *testing.T) {
+	details := encoders.Details(nonFiniteErrors())
+	want := map[string]interface{}{"min": 0, "max": "+Inf", "low": "-Inf", "mid": "NaN"}
+	if len(details) != 1 || !reflect.DeepEqual(details[0].Params, want) {
+		t.Errorf("encoders.Details failed with details=%v, want params=%v", details, want)
+	}
+}
+
+func groupedErrors() error {
+	return guard.Validate(guard.Field("contact", guard.AnyOf(
+		guard.Field("phone", &validators.StringNotBlank{}),
+		&validators.StringLength{Value: "a", Min: 3, Max: 5},
+	)))
+}
+
+func TestDetailsWithGroupedErrors(t *testing.T) {
+	details := encoders.Details(groupedErrors())
+	want := []encoders.Detail{{
+		Message: "should satisfy any of the rules",
+		Field:   "contact",
+		Code:    guard.CodeAnyOf,
+		Errors: []encoders.Detail{
+			{Message: "shouldn't be blank", Field: "contact.phone", Code: validators.CodeStringBlank},
+			{
+				Message: "too short",
+				Field:   "contact",
+				Code:    validators.CodeStringTooShort,
+				Params:  map[string]interface{}{"min": 3, "max": 5},
+			},
+		},
+	}}
+	if !reflect.DeepEqual(details, want) {
+		t.Errorf("encoders.Details failed with details=%+v, want details=%+v", details, want)
+	}
+}
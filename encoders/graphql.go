@@ -0,0 +1,59 @@
+package encoders
+
+// GraphQLCode is the code of GraphQL error extensions of validation errors.
+const GraphQLCode = "VALIDATION_FAILED"
+
+// GraphQLError is a GraphQL error of a validation error.
+//
+// Member "extensions" carries the code "VALIDATION_FAILED", and the field path,
+// the field path segments, the validation code and the parameters of the validation error.
+// The grouped validation errors of guard.AnyOf and guard.OneOf are carried by
+// member "errors" of "extensions", as GraphQL errors.
+type GraphQLError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// NewGraphQLErrors returns the GraphQL errors of the validation errors of err.
+// The response path of every error is set to path, if it isn't empty.
+//
+// If err isn't a validation error, NewGraphQLErrors returns nil.
+func NewGraphQLErrors(err error, path ...interface{}) []GraphQLError {
+	details := Details(err)
+	if details == nil {
+		return nil
+	}
+
+	errs := make([]GraphQLError, 0, len(details))
+	for _, d := range details {
+		errs = append(errs, graphQLError(d, path))
+	}
+	return errs
+}
+
+func graphQLError(d Detail, path []interface{}) GraphQLError {
+	ext := map[string]interface{}{"code": GraphQLCode}
+	if d.Field != "" {
+		ext["field"] = d.Field
+		ext["fieldPath"] = splitPath(d.Field)
+	}
+	if d.Code != "" {
+		ext["validationCode"] = d.Code
+	}
+	if len(d.Params) != 0 {
+		ext["params"] = d.Params
+	}
+	if len(d.Errors) != 0 {
+		children := make([]GraphQLError, 0, len(d.Errors))
+		for _, child := range d.Errors {
+			children = append(children, graphQLError(child, path))
+		}
+		ext["errors"] = children
+	}
+	return GraphQLError{
+		Message:    d.Message,
+		Path:       path,
+		Extensions: ext,
+	}
+}
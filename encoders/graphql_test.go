@@ -0,0 +1,73 @@
+package encoders_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nauyey/guard"
+	"github.com/nauyey/guard/encoders"
+	"github.com/nauyey/guard/validators"
+)
+
+func TestNewGraphQLErrors(t *testing.T) {
+	data, err := json.Marshal(encoders.NewGraphQLErrors(testErrors(), "createOrder"))
+	if err != nil {
+		t.Fatalf("encoders.NewGraphQLErrors failed with err=%v", err)
+	}
+	want := `[{"message":"should be great than","path":["createOrder"],"extensions":{"code":"VALIDATION_FAILED",` +
+		`"field":"items[3].price","fieldPath":["items",3,"price"],"params":{"target":0},"validationCode":"number.not_greater_than"}},` +
+		`{"message":"shouldn't be blank","path":["createOrder"],"extensions":{"code":"VALIDATION_FAILED","validationCode":"string.blank"}}]`
+	if string(data) != want {
+		t.Errorf("encoders.NewGraphQLErrors failed with json=%s, want json=%s", data, want)
+	}
+
+	if errs := encoders.NewGraphQLErrors(errors.New("non-validation error")); errs != nil {
+		t.Errorf("encoders.NewGraphQLErrors failed with non-validation error")
+	}
+}
+
+func TestNewGraphQLErrorsWithMapKeys(t *testing.T) {
+	err := guard.Validate(guard.Field("labels", &validators.EachEntry[string, string]{
+		Value: map[string]string{"app.kubernetes.io/name": "", "": "", "[3]": ""},
+		Validator: func(key string, value string) guard.Validator {
+			return &validators.StringNotBlank{Value: value}
+		},
+	}), guard.Field("items", guard.Index(3, guard.Field("tags", guard.Index(0, &validators.StringNotBlank{})))))
+
+	var got []interface{}
+	for _, e := range encoders.NewGraphQLErrors(err) {
+		got = append(got, e.Extensions["fieldPath"])
+	}
+	want := []interface{}{
+		[]interface{}{"labels", ""},
+		[]interface{}{"labels", "[3]"},
+		[]interface{}{"labels", "app.kubernetes.io/name"},
+		[]interface{}{"items", 3, "tags", 0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("encoders.NewGraphQLErrors failed with field paths=%v, want field paths=%v", got, want)
+	}
+}
+
+func TestNewGraphQLErrorsWithNonFiniteParams(t *testing.T) {
+	data, err := json.Marshal(encoders.NewGraphQLErrors(nonFiniteErrors()))
+	if err != nil {
+		t.Fatalf("encoders.NewGraphQLErrors failed with err=%v", err)
+	}
+	if want := `"params":{"low":"-Inf","max":"+Inf","mid":"NaN","min":0}`; !strings.Contains(string(data), want) {
+		t.Errorf("encoders.NewGraphQLErrors failed with json=%s, want %s", data, want)
+	}
+}
+
+func TestNewGraphQLErrorsWithGroupedErrors(t *testing.T) {
+	data, err := json.Marshal(encoders.NewGraphQLErrors(groupedErrors()))
+	if err != nil {
+		t.Fatalf("encoders.NewGraphQLErrors failed with err=%v", err)
+	}
+	if want := `"errors":[{"message":"shouldn't be blank","extensions":{"code":"VALIDATION_FAILED","field":"contact.phone","fieldPath":["contact","phone"],"validationCode":"string.blank"}}`; !strings.Contains(string(data), want) {
+		t.Errorf("encoders.NewGraphQLErrors failed with json=%s, want %s", data, want)
+	}
+}
@@ -0,0 +1,89 @@
+package encoders
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// JSONAPIContentType is the media type of JSON:API documents.
+const JSONAPIContentType = "application/vnd.api+json"
+
+// JSONAPIDocument is a JSON:API document which contains error objects.
+type JSONAPIDocument struct {
+	Errors []JSONAPIError `json:"errors"`
+}
+
+// JSONAPIError is a JSON:API error object of a validation error.
+//
+// The parameters of the validation error are carried by member "meta".
+// The grouped validation errors of guard.AnyOf and guard.OneOf are carried by
+// member "errors" of "meta", as JSON:API error objects.
+type JSONAPIError struct {
+	Status string                 `json:"status,omitempty"`
+	Code   string                 `json:"code,omitempty"`
+	Title  string                 `json:"title,omitempty"`
+	Detail string                 `json:"detail,omitempty"`
+	Source *JSONAPISource         `json:"source,omitempty"`
+	Meta   map[string]interface{} `json:"meta,omitempty"`
+}
+
+// JSONAPISource is the source member of a JSON:API error object.
+type JSONAPISource struct {
+	Pointer string `json:"pointer,omitempty"`
+}
+
+// NewJSONAPIDocument returns a JSON:API document of the validation errors of err.
+//
+// Field paths are encoded as JSON pointers to the attributes of the primary data,
+// so field path "items[3].price" is pointed by "/data/attributes/items/3/price".
+//
+// If err isn't a validation error, NewJSONAPIDocument returns nil.
+func NewJSONAPIDocument(err error) *JSONAPIDocument {
+	details := Details(err)
+	if details == nil {
+		return nil
+	}
+
+	doc := &JSONAPIDocument{Errors: make([]JSONAPIError, 0, len(details))}
+	for _, d := range details {
+		doc.Errors = append(doc.Errors, jsonAPIError(d))
+	}
+	return doc
+}
+
+func jsonAPIError(d Detail) JSONAPIError {
+	e := JSONAPIError{
+		Status: strconv.Itoa(http.StatusUnprocessableEntity),
+		Code:   d.Code,
+		Title:  "Invalid Attribute",
+		Detail: d.Message,
+		Meta:   d.Params,
+	}
+	if d.Field != "" {
+		e.Source = &JSONAPISource{Pointer: jsonPointer("/data/attributes", d.Field)}
+	}
+	if len(d.Errors) != 0 {
+		children := make([]JSONAPIError, 0, len(d.Errors))
+		for _, child := range d.Errors {
+			children = append(children, jsonAPIError(child))
+		}
+		e.Meta = make(map[string]interface{}, len(d.Params)+1)
+		for k, v := range d.Params {
+			e.Meta[k] = v
+		}
+		e.Meta["errors"] = children
+	}
+	return e
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func jsonPointer(prefix string, path string) string {
+	pointer := prefix
+	for _, s := range splitPath(path) {
+		pointer += "/" + pointerEscaper.Replace(fmt.Sprint(s))
+	}
+	return pointer
+}
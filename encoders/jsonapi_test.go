@@ -0,0 +1,66 @@
+package encoders_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/nauyey/guard"
+	"github.com/nauyey/guard/encoders"
+	"github.com/nauyey/guard/validators"
+)
+
+func TestNewJSONAPIDocument(t *testing.T) {
+	data, err := json.Marshal(encoders.NewJSONAPIDocument(testErrors()))
+	if err != nil {
+		t.Fatalf("encoders.NewJSONAPIDocument failed with err=%v", err)
+	}
+	want := `{"errors":[` +
+		`{"status":"422","code":"number.not_greater_than","title":"Invalid Attribute","detail":"should be great than",` +
+		`"source":{"pointer":"/data/attributes/items/3/price"},"meta":{"target":0}},` +
+		`{"status":"422","code":"string.blank","title":"Invalid Attribute","detail":"shouldn't be blank"}]}`
+	if string(data) != want {
+		t.Errorf("encoders.NewJSONAPIDocument failed with json=%s, want json=%s", data, want)
+	}
+
+	if doc := encoders.NewJSONAPIDocument(errors.New("non-validation error")); doc != nil {
+		t.Errorf("encoders.NewJSONAPIDocument failed with non-validation error")
+	}
+}
+
+func TestNewJSONAPIDocumentWithMapKeys(t *testing.T) {
+	err := guard.Validate(guard.Field("labels", &validators.EachEntry[string, string]{
+		Value: map[string]string{"app.kubernetes.io/name": ""},
+		Validator: func(key string, value string) guard.Validator {
+			return &validators.StringNotBlank{Value: value}
+		},
+	}))
+	doc := encoders.NewJSONAPIDocument(err)
+	if len(doc.Errors) != 1 || doc.Errors[0].Source == nil {
+		t.Fatalf("encoders.NewJSONAPIDocument failed with errors=%v", doc.Errors)
+	}
+	if pointer := doc.Errors[0].Source.Pointer; pointer != "/data/attributes/labels/app.kubernetes.io~1name" {
+		t.Errorf("encoders.NewJSONAPIDocument failed with pointer=%q", pointer)
+	}
+}
+
+func TestNewJSONAPIDocumentWithNonFiniteParams(t *testing.T) {
+	data, err := json.Marshal(encoders.NewJSONAPIDocument(nonFiniteErrors()))
+	if err != nil {
+		t.Fatalf("encoders.NewJSONAPIDocument failed with err=%v", err)
+	}
+	if want := `"meta":{"low":"-Inf","max":"+Inf","mid":"NaN","min":0}`; !strings.Contains(string(data), want) {
+		t.Errorf("encoders.NewJSONAPIDocument failed with json=%s, want %s", data, want)
+	}
+}
+
+func TestNewJSONAPIDocumentWithGroupedErrors(t *testing.T) {
+	data, err := json.Marshal(encoders.NewJSONAPIDocument(groupedErrors()))
+	if err != nil {
+		t.Fatalf("encoders.NewJSONAPIDocument failed with err=%v", err)
+	}
+	if want := `"meta":{"errors":[{"status":"422","code":"string.blank","title":"Invalid Attribute","detail":"shouldn't be blank","source":{"pointer":"/data/attributes/contact/phone"}}`; !strings.Contains(string(data), want) {
+		t.Errorf("encoders.NewJSONAPIDocument failed with json=%s, want %s", data, want)
+	}
+}
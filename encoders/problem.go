@@ -0,0 +1,39 @@
+package encoders
+
+import "net/http"
+
+// ProblemContentType is the media type of RFC 7807 problem details.
+const ProblemContentType = "application/problem+json"
+
+// Problem is a RFC 7807 problem details object of validation errors.
+//
+// The validation errors are described by the extension member "errors".
+// The grouped validation errors of guard.AnyOf and guard.OneOf are described by
+// member "errors" of their details.
+type Problem struct {
+	Type     string   `json:"type,omitempty"`
+	Title    string   `json:"title,omitempty"`
+	Status   int      `json:"status,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+	Instance string   `json:"instance,omitempty"`
+	Errors   []Detail `json:"errors"`
+}
+
+// NewProblem returns a problem details object of the validation errors of err,
+// with status 422 Unprocessable Entity.
+//
+// If err isn't a validation error, NewProblem returns nil.
+func NewProblem(err error) *Problem {
+	details := Details(err)
+	if details == nil {
+		return nil
+	}
+
+	return &Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(http.StatusUnprocessableEntity),
+		Status: http.StatusUnprocessableEntity,
+		Detail: "validation errors",
+		Errors: details,
+	}
+}
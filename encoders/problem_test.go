@@ -0,0 +1,47 @@
+package encoders_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/nauyey/guard/encoders"
+)
+
+func TestNewProblem(t *testing.T) {
+	data, err := json.Marshal(encoders.NewProblem(testErrors()))
+	if err != nil {
+		t.Fatalf("encoders.NewProblem failed with err=%v", err)
+	}
+	want := `{"type":"about:blank","title":"Unprocessable Entity","status":422,"detail":"validation errors",` +
+		`"errors":[{"message":"should be great than","field":"items[3].price","code":"number.not_greater_than","params":{"target":0}},` +
+		`{"message":"shouldn't be blank","code":"string.blank"}]}`
+	if string(data) != want {
+		t.Errorf("encoders.NewProblem failed with json=%s, want json=%s", data, want)
+	}
+
+	if p := encoders.NewProblem(errors.New("non-validation error")); p != nil {
+		t.Errorf("encoders.NewProblem failed with non-validation error")
+	}
+}
+
+func TestNewProblemWithNonFiniteParams(t *testing.T) {
+	data, err := json.Marshal(encoders.NewProblem(nonFiniteErrors()))
+	if err != nil {
+		t.Fatalf("encoders.NewProblem failed with err=%v", err)
+	}
+	if want := `"params":{"low":"-Inf","max":"+Inf","mid":"NaN","min":0}`; !strings.Contains(string(data), want) {
+		t.Errorf("encoders.NewProblem failed with json=%s, want %s", data, want)
+	}
+}
+
+func TestNewProblemWithGroupedErrors(t *testing.T) {
+	data, err := json.Marshal(encoders.NewProblem(groupedErrors()))
+	if err != nil {
+		t.Fatalf("encoders.NewProblem failed with err=%v", err)
+	}
+	if want := `"errors":[{"message":"shouldn't be blank","field":"contact.phone","code":"string.blank"}`; !strings.Contains(string(data), want) {
+		t.Errorf("encoders.NewProblem failed with json=%s, want %s", data, want)
+	}
+}
@@ -12,3 +12,31 @@ type Error interface {
 type Errors interface {
 	ValidationErrors() []error
 }
+
+// FieldError is the interface that defines a validation error bound to a field.
+//
+// Field returns the path of the invalid field, like "author.name" or "items[3].price".
+type FieldError interface {
+	Error
+	Field() string
+}
+
+// CodedError is the interface that defines a machine-readable validation error.
+//
+// Code returns a stable error code, like "string.too_short".
+// Params returns the parameters of the failed validation, like the bounds of a range.
+type CodedError interface {
+	Error
+	Code() string
+	Params() map[string]interface{}
+}
+
+// GroupError is the interface that defines a validation error which groups the validation errors
+// of combined validators, like the ones returned by AnyOf and OneOf.
+//
+// GroupedErrors returns the grouped validation errors. They aren't returned by an Unwrap method,
+// so the field path and the code of a GroupError aren't taken from the grouped validation errors.
+type GroupError interface {
+	Error
+	GroupedErrors() []error
+}
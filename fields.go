@@ -0,0 +1,108 @@
+package guard
+
+import (
+	"context"
+	goerrors "errors"
+	"strconv"
+	"strings"
+)
+
+// Field names the field validated by a validator.
+//
+// Every validation error returned by the validator will be bound to the field name.
+// If the validator is a nested struct whose errors already carry field paths,
+// like "name", the paths will be prefixed by the field name, like "author.name".
+//
+// If the validator is a strict validator, the returned validator is strict, too.
+func Field(name string, v Validator) Validator {
+	if _, ok := v.(*strictValidators); ok {
+		return Strict(&fieldValidator{name: name, validator: v})
+	}
+	return &fieldValidator{name: name, validator: v}
+}
+
+// Index names the element at index i of a slice validated by a validator.
+//
+// It works the same as Field, but the path element is "[i]".
+// So guard.Field("items", guard.Index(3, item)) binds the errors of item to "items[3]".
+func Index(i int, v Validator) Validator {
+	return Field("["+strconv.Itoa(i)+"]", v)
+}
+
+type fieldValidator struct {
+	name      string
+	validator Validator
+}
+
+// Validate implements the Validator interface
+func (v *fieldValidator) Validate() error {
+	return v.ValidateContext(context.Background())
+}
+
+// ValidateContext implements the ContextValidator interface
+func (v *fieldValidator) ValidateContext(ctx context.Context) error {
+	return v.bind(validate(ctx, v.validator))
+}
+
+func (v *fieldValidator) bind(err error) error {
+	switch vErr := err.(type) {
+	default:
+		return err
+	case Errors:
+		errs := make([]error, 0, len(vErr.ValidationErrors()))
+		for _, e := range vErr.ValidationErrors() {
+			errs = append(errs, bindField(v.name, e))
+		}
+		return &errors{errs: errs}
+	case Error:
+		return bindField(v.name, err)
+	}
+}
+
+func bindField(name string, err error) error {
+	var fErr FieldError
+	if !goerrors.As(err, &fErr) {
+		return &fieldError{path: name, err: err}
+	}
+
+	path := joinPath(name, fErr.Field())
+	if e, ok := err.(*fieldError); ok {
+		err = e.err
+	}
+	return &fieldError{path: path, err: err}
+}
+
+func joinPath(parent, child string) string {
+	switch {
+	case parent == "":
+		return child
+	case child == "":
+		return parent
+	case strings.HasPrefix(child, "["):
+		return parent + child
+	}
+	return parent + "." + child
+}
+
+type fieldError struct {
+	path string
+	err  error
+}
+
+// Error implements the error interface
+func (err *fieldError) Error() string {
+	return err.err.Error()
+}
+
+// ValidationError implements the Error interface
+func (err *fieldError) ValidationError() {}
+
+// Field implements the FieldError interface
+func (err *fieldError) Field() string {
+	return err.path
+}
+
+// Unwrap returns the validation error bound to the field
+func (err *fieldError) Unwrap() error {
+	return err.err
+}
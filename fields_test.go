@@ -0,0 +1,103 @@
+package guard_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nauyey/guard"
+)
+
+func fieldOf(err error) string {
+	if fErr, ok := err.(guard.FieldError); ok {
+		return fErr.Field()
+	}
+	return ""
+}
+
+func TestField(t *testing.T) {
+	// test without validation errors
+	err := guard.Validate(
+		guard.Field("title", &testValidator{}),
+	)
+	if err != nil {
+		t.Errorf("guard.Field failed with err=%v", err)
+	}
+
+	// test with validation errors
+	err = guard.Validate(
+		guard.Field("title", &testValidator{
+			err: &validationError{msg: "shouldn't be blank"},
+		}),
+		&testValidator{
+			err: &validationError{},
+		},
+	)
+	errs, ok := err.(guard.Errors)
+	if !ok {
+		t.Fatalf("guard.Field failed to return err(type guard.Errors)")
+	}
+	vErrs := errs.ValidationErrors()
+	if len(vErrs) != 2 {
+		t.Fatalf("guard.Field failed with len(vErrs)=%d, want len(vErrs)=2", len(vErrs))
+	}
+	if fieldOf(vErrs[0]) != "title" || vErrs[0].Error() != "shouldn't be blank" {
+		t.Errorf("guard.Field failed with field=%q, message=%q", fieldOf(vErrs[0]), vErrs[0].Error())
+	}
+	if fieldOf(vErrs[1]) != "" {
+		t.Errorf("guard.Field failed with field=%q, want field=\"\"", fieldOf(vErrs[1]))
+	}
+
+	// test with non-validation errors
+	nonValidationErr := &testValidator{err: errors.New("non-validation error")}
+	err = guard.Validate(guard.Field("title", nonValidationErr))
+	if _, ok := err.(guard.FieldError); ok {
+		t.Errorf("guard.Field failed to return non-validation error")
+	}
+}
+
+func TestFieldNested(t *testing.T) {
+	author := &testValidator{
+		err: guard.Validate(
+			guard.Field("name", &testValidator{err: &validationError{}}),
+			guard.Field("age", &testValidator{err: &validationError{}}),
+		),
+	}
+	item := &testValidator{
+		err: guard.Validate(
+			guard.Field("price", &testValidator{err: &validationError{}}),
+		),
+	}
+
+	err := guard.Validate(
+		guard.Field("author", author),
+		guard.Field("items", guard.Index(3, item)),
+	)
+	errs, ok := err.(guard.Errors)
+	if !ok {
+		t.Fatalf("guard.Field failed to return err(type guard.Errors)")
+	}
+	vErrs := errs.ValidationErrors()
+	want := []string{"author.name", "author.age", "items[3].price"}
+	if len(vErrs) != len(want) {
+		t.Fatalf("guard.Field failed with len(vErrs)=%d, want len(vErrs)=%d", len(vErrs), len(want))
+	}
+	for i, path := range want {
+		if fieldOf(vErrs[i]) != path {
+			t.Errorf("guard.Field failed with field=%q, want field=%q", fieldOf(vErrs[i]), path)
+		}
+	}
+}
+
+func TestFieldStrict(t *testing.T) {
+	err := guard.Validate(
+		guard.Field("title", guard.Strict(&testValidator{err: &validationError{}})),
+		&testValidator{err: &validationError{}},
+	)
+	errs, ok := err.(guard.Errors)
+	if !ok {
+		t.Fatalf("guard.Field failed to return err(type guard.Errors)")
+	}
+	if len(errs.ValidationErrors()) != 1 {
+		t.Errorf("guard.Field failed with len(vErrs)=%d, want len(vErrs)=1", len(errs.ValidationErrors()))
+	}
+}
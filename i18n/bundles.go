@@ -0,0 +1,380 @@
+package i18n
+
+import (
+	"github.com/nauyey/guard"
+	"github.com/nauyey/guard/validators"
+)
+
+// Default is the catalog of the built-in bundles.
+var Default = NewCatalog(English, Chinese, German)
+
+// Translate translates the validation errors of err into the locale by the Default catalog.
+func Translate(locale string, err error) error {
+	return Default.Translate(locale, err)
+}
+
+// English is the bundle of the built-in validators in English.
+var English = &Bundle{
+	Locale: "en",
+	Plural: pluralOneOther,
+	Messages: map[string]Message{
+		validators.CodeNil:                     {Other: "shouldn't be nil"},
+		validators.CodeNotOdd:                  {Other: "should be odd"},
+		validators.CodeNotEven:                 {Other: "should be even"},
+		validators.CodeNotGreaterThan:          {Other: "should be greater than {target}"},
+		validators.CodeNotGreaterThanOrEqualTo: {Other: "should be greater than or equal to {target}"},
+		validators.CodeNotEqualTo:              {Other: "should equal to {target}"},
+		validators.CodeNotLessThan:             {Other: "should be less than {target}"},
+		validators.CodeNotLessThanOrEqualTo:    {Other: "should be less than or equal to {target}"},
+		validators.CodeOutOfLeftRange:          {Other: "should be greater than or equal to {left}"},
+		validators.CodeOutOfRightRange:         {Other: "should be less than or equal to {right}"},
+		validators.CodeStringBlank:             {Other: "shouldn't be blank"},
+		validators.CodeStringNotIncluded:       {Other: "should be one of {in}"},
+		validators.CodeStringExcluded:          {Other: "shouldn't be one of {in}"},
+		validators.CodeStringTooShort: {
+			Count: "min",
+			One:   "is too short (minimum is {min} character)",
+			Other: "is too short (minimum is {min} characters)",
+		},
+		validators.CodeStringTooLong: {
+			Count: "max",
+			One:   "is too long (maximum is {max} character)",
+			Other: "is too long (maximum is {max} characters)",
+		},
+		validators.CodeNaN:            {Other: "should be a number"},
+		validators.CodeNotFinite:      {Other: "should be finite"},
+		validators.CodeNotApproxEqual: {Other: "should approximately equal to {target}"},
+		validators.CodeBelowRange:     {Other: "should be greater than or equal to {min}"},
+		validators.CodeAboveRange:     {Other: "should be less than or equal to {max}"},
+		validators.CodeTooManyDecimalPlaces: {
+			Count: "places",
+			One:   "should have at most {places} decimal place",
+			Other: "should have at most {places} decimal places",
+		},
+		validators.CodeStringNotMatched:        {Other: "should match the pattern {pattern}"},
+		validators.CodeStringMatched:           {Other: "shouldn't match the pattern {pattern}"},
+		validators.CodeEmailMalformed:          {Other: "should be an email address"},
+		validators.CodeEmailDomainNotAllowed:   {Other: "email domain {domain} isn't allowed"},
+		validators.CodeEmailTooLong:            {Other: "email address is too long (maximum is {max} characters)"},
+		validators.CodeURLMalformed:            {Other: "should be an URL"},
+		validators.CodeURLNotAbsolute:          {Other: "should be an absolute URL"},
+		validators.CodeURLSchemeNotAllowed:     {Other: "URL scheme should be one of {schemes}"},
+		validators.CodeURLUserinfoForbidden:    {Other: "URL shouldn't contain user information"},
+		validators.CodeURLIPHostForbidden:      {Other: "URL host shouldn't be an IP address"},
+		validators.CodeURLPrivateHostForbidden: {Other: "URL host shouldn't be a private host"},
+		validators.CodeURLTooLong:              {Other: "URL is too long (maximum is {max} characters)"},
+		validators.CodeURLQueryRequired:        {Other: "URL should contain a query"},
+		validators.CodeURLQueryForbidden:       {Other: "URL shouldn't contain a query"},
+		validators.CodeURLFragmentRequired:     {Other: "URL should contain a fragment"},
+		validators.CodeURLFragmentForbidden:    {Other: "URL shouldn't contain a fragment"},
+		validators.CodeIPMalformed:             {Other: "should be an IP address"},
+		validators.CodeIPWrongVersion:          {Other: "should be an IPv{version} address"},
+		validators.CodeIPNotInPrefixes:         {Other: "should be in one of the networks {prefixes}"},
+		validators.CodeIPSpecial:               {Other: "shouldn't be a {range} IP address"},
+		validators.CodeCIDRMalformed:           {Other: "should be a CIDR prefix"},
+		validators.CodeCIDRWrongVersion:        {Other: "should be an IPv{version} CIDR prefix"},
+		validators.CodeCIDRTooShort:            {Other: "prefix length should be at least {min}"},
+		validators.CodeCIDRTooLong:             {Other: "prefix length should be at most {max}"},
+		validators.CodeCIDRNotMasked:           {Other: "shouldn't have host bits set, use {masked}"},
+		validators.CodeUUIDMalformed:           {Other: "should be an UUID"},
+		validators.CodeUUIDWrongVersion:        {Other: "should be a version {version} UUID"},
+		validators.CodeUUIDWrongVariant:        {Other: "should be a RFC 9562 variant UUID"},
+		validators.CodeULIDMalformed:           {Other: "should be an ULID"},
+		validators.CodeULIDTimeOutOfRange:      {Other: "ULID time is out of range"},
+		validators.CodeTimeNotBefore:           {Other: "should be before {target}"},
+		validators.CodeTimeNotAfter:            {Other: "should be after {target}"},
+		validators.CodeTimeTooEarly:            {Other: "is too early"},
+		validators.CodeTimeTooLate:             {Other: "is too late"},
+		validators.CodeTimeZero:                {Other: "shouldn't be empty"},
+		validators.CodeTimeNotInFuture:         {Other: "should be in the future"},
+		validators.CodeTimeNotInPast:           {Other: "should be in the past"},
+		validators.CodeDurationTooShort:        {Other: "should be at least {min}"},
+		validators.CodeDurationTooLong:         {Other: "should be at most {max}"},
+		validators.CodeTimeMalformed:           {Other: "should be a time in the format {layouts}"},
+		validators.CodeSliceTooFew: {
+			Count: "min",
+			One:   "should have at least {min} item",
+			Other: "should have at least {min} items",
+		},
+		validators.CodeSliceTooMany: {
+			Count: "max",
+			One:   "should have at most {max} item",
+			Other: "should have at most {max} items",
+		},
+		validators.CodeSliceNotUnique:    {Other: "items should be unique"},
+		validators.CodeSliceMissing:      {Other: "should contain all of {targets}"},
+		validators.CodeSliceContainsNone: {Other: "should contain any of {targets}"},
+		validators.CodeSliceNotSubset:    {Other: "items should be in {allowed}"},
+		validators.CodeMapMissingKeys:    {Other: "should contain the keys {missing}"},
+		validators.CodeMapKeyNotAllowed:  {Other: "keys should be in {allowed}"},
+		validators.CodeMapKeyNotMatched:  {Other: "keys should match the pattern {pattern}"},
+		validators.CodeMapTooFew: {
+			Count: "min",
+			One:   "should have at least {min} entry",
+			Other: "should have at least {min} entries",
+		},
+		validators.CodeMapTooMany: {
+			Count: "max",
+			One:   "should have at most {max} entry",
+			Other: "should have at most {max} entries",
+		},
+		guard.CodeAnyOf:                        {Other: "should satisfy any of the rules"},
+		guard.CodeOneOfNone:                    {Other: "should satisfy one of the rules"},
+		guard.CodeOneOfMany:                    {Other: "should satisfy only one of the rules"},
+		guard.CodeNot:                          {Other: "is invalid"},
+		validators.CodeFieldsNotEqual:          {Other: "should equal to {other}"},
+		validators.CodeFieldsEqual:             {Other: "shouldn't equal to {other}"},
+		validators.CodeFieldsNotLess:           {Other: "should be less than {other}"},
+		validators.CodeFieldsNotLessOrEqual:    {Other: "should be less than or equal to {other}"},
+		validators.CodeFieldsNotGreater:        {Other: "should be greater than {other}"},
+		validators.CodeFieldsNotGreaterOrEqual: {Other: "should be greater than or equal to {other}"},
+		validators.CodeFieldsNotBothSet:        {Other: "should be set with {other}"},
+		validators.CodeFieldsSet:               {Other: "shouldn't be set with {other}"},
+		validators.CodeGroupNotExactlyOne:      {Other: "exactly one of {fields} should be present"},
+		validators.CodeGroupNonePresent:        {Other: "at least one of {fields} should be present"},
+		validators.CodeGroupManyPresent:        {Other: "at most one of {fields} should be present"},
+		validators.CodeGroupMissingRequired:    {Other: "{fields} should be present with {with}"},
+		validators.CodeMissing:                 {Other: "should be present"},
+		validators.CodeEmpty:                   {Other: "shouldn't be empty"},
+		validators.CodeZero:                    {Other: "shouldn't be zero"},
+	},
+}
+
+// Chinese is the bundle of the built-in validators in Chinese.
+var Chinese = &Bundle{
+	Locale: "zh",
+	Plural: pluralOther,
+	Messages: map[string]Message{
+		validators.CodeNil:                     {Other: "不能为空"},
+		validators.CodeNotOdd:                  {Other: "必须是奇数"},
+		validators.CodeNotEven:                 {Other: "必须是偶数"},
+		validators.CodeNotGreaterThan:          {Other: "必须大于{target}"},
+		validators.CodeNotGreaterThanOrEqualTo: {Other: "必须大于或等于{target}"},
+		validators.CodeNotEqualTo:              {Other: "必须等于{target}"},
+		validators.CodeNotLessThan:             {Other: "必须小于{target}"},
+		validators.CodeNotLessThanOrEqualTo:    {Other: "必须小于或等于{target}"},
+		validators.CodeOutOfLeftRange:          {Other: "必须大于或等于{left}"},
+		validators.CodeOutOfRightRange:         {Other: "必须小于或等于{right}"},
+		validators.CodeStringBlank:             {Other: "不能为空白"},
+		validators.CodeStringNotIncluded:       {Other: "必须是{in}之一"},
+		validators.CodeStringExcluded:          {Other: "不能是{in}之一"},
+		validators.CodeStringTooShort:          {Count: "min", Other: "过短（最少{min}个字符）"},
+		validators.CodeStringTooLong:           {Count: "max", Other: "过长（最多{max}个字符）"},
+		validators.CodeNaN:                     {Other: "必须是数字"},
+		validators.CodeNotFinite:               {Other: "必须是有限数"},
+		validators.CodeNotApproxEqual:          {Other: "必须约等于{target}"},
+		validators.CodeBelowRange:              {Other: "必须大于或等于{min}"},
+		validators.CodeAboveRange:              {Other: "必须小于或等于{max}"},
+		validators.CodeTooManyDecimalPlaces:    {Count: "places", Other: "最多{places}位小数"},
+		validators.CodeStringNotMatched:        {Other: "必须匹配{pattern}"},
+		validators.CodeStringMatched:           {Other: "不能匹配{pattern}"},
+		validators.CodeEmailMalformed:          {Other: "必须是有效的电子邮件地址"},
+		validators.CodeEmailDomainNotAllowed:   {Other: "不允许使用电子邮件域名{domain}"},
+		validators.CodeEmailTooLong:            {Other: "电子邮件地址过长（最多{max}个字符）"},
+		validators.CodeURLMalformed:            {Other: "必须是有效的URL"},
+		validators.CodeURLNotAbsolute:          {Other: "必须是绝对URL"},
+		validators.CodeURLSchemeNotAllowed:     {Other: "URL协议必须是{schemes}之一"},
+		validators.CodeURLUserinfoForbidden:    {Other: "URL不能包含用户信息"},
+		validators.CodeURLIPHostForbidden:      {Other: "URL主机不能是IP地址"},
+		validators.CodeURLPrivateHostForbidden: {Other: "URL主机不能是私有主机"},
+		validators.CodeURLTooLong:              {Other: "URL过长（最多{max}个字符）"},
+		validators.CodeURLQueryRequired:        {Other: "URL必须包含查询参数"},
+		validators.CodeURLQueryForbidden:       {Other: "URL不能包含查询参数"},
+		validators.CodeURLFragmentRequired:     {Other: "URL必须包含片段"},
+		validators.CodeURLFragmentForbidden:    {Other: "URL不能包含片段"},
+		validators.CodeIPMalformed:             {Other: "必须是有效的IP地址"},
+		validators.CodeIPWrongVersion:          {Other: "必须是IPv{version}地址"},
+		validators.CodeIPNotInPrefixes:         {Other: "必须在网络{prefixes}之一中"},
+		validators.CodeIPSpecial:               {Other: "不能是特殊IP地址（{range}）"},
+		validators.CodeCIDRMalformed:           {Other: "必须是有效的CIDR前缀"},
+		validators.CodeCIDRWrongVersion:        {Other: "必须是IPv{version} CIDR前缀"},
+		validators.CodeCIDRTooShort:            {Other: "前缀长度最少为{min}"},
+		validators.CodeCIDRTooLong:             {Other: "前缀长度最多为{max}"},
+		validators.CodeCIDRNotMasked:           {Other: "不能设置主机位，请使用{masked}"},
+		validators.CodeUUIDMalformed:           {Other: "必须是有效的UUID"},
+		validators.CodeUUIDWrongVersion:        {Other: "必须是版本{version}的UUID"},
+		validators.CodeUUIDWrongVariant:        {Other: "必须是RFC 9562变体的UUID"},
+		validators.CodeULIDMalformed:           {Other: "必须是有效的ULID"},
+		validators.CodeULIDTimeOutOfRange:      {Other: "ULID时间超出范围"},
+		validators.CodeTimeNotBefore:           {Other: "必须早于{target}"},
+		validators.CodeTimeNotAfter:            {Other: "必须晚于{target}"},
+		validators.CodeTimeTooEarly:            {Other: "时间过早"},
+		validators.CodeTimeTooLate:             {Other: "时间过晚"},
+		validators.CodeTimeZero:                {Other: "不能为空"},
+		validators.CodeTimeNotInFuture:         {Other: "必须是将来的时间"},
+		validators.CodeTimeNotInPast:           {Other: "必须是过去的时间"},
+		validators.CodeDurationTooShort:        {Other: "最少为{min}"},
+		validators.CodeDurationTooLong:         {Other: "最多为{max}"},
+		validators.CodeTimeMalformed:           {Other: "必须是格式为{layouts}的时间"},
+		validators.CodeSliceTooFew:             {Other: "至少需要{min}项"},
+		validators.CodeSliceTooMany:            {Other: "最多只能有{max}项"},
+		validators.CodeSliceNotUnique:          {Other: "不能包含重复项"},
+		validators.CodeSliceMissing:            {Other: "必须包含{targets}中的所有项"},
+		validators.CodeSliceContainsNone:       {Other: "必须包含{targets}中的任意一项"},
+		validators.CodeSliceNotSubset:          {Other: "所有项必须在{allowed}之中"},
+		validators.CodeMapMissingKeys:          {Other: "必须包含键{missing}"},
+		validators.CodeMapKeyNotAllowed:        {Other: "键必须在{allowed}之中"},
+		validators.CodeMapKeyNotMatched:        {Other: "键必须匹配{pattern}"},
+		validators.CodeMapTooFew:               {Other: "至少需要{min}个条目"},
+		validators.CodeMapTooMany:              {Other: "最多只能有{max}个条目"},
+		guard.CodeAnyOf:                        {Other: "必须满足任意一条规则"},
+		guard.CodeOneOfNone:                    {Other: "必须满足其中一条规则"},
+		guard.CodeOneOfMany:                    {Other: "只能满足其中一条规则"},
+		guard.CodeNot:                          {Other: "无效"},
+		validators.CodeFieldsNotEqual:          {Other: "必须与{other}相同"},
+		validators.CodeFieldsEqual:             {Other: "不能与{other}相同"},
+		validators.CodeFieldsNotLess:           {Other: "必须小于{other}"},
+		validators.CodeFieldsNotLessOrEqual:    {Other: "必须小于或等于{other}"},
+		validators.CodeFieldsNotGreater:        {Other: "必须大于{other}"},
+		validators.CodeFieldsNotGreaterOrEqual: {Other: "必须大于或等于{other}"},
+		validators.CodeFieldsNotBothSet:        {Other: "必须与{other}同时填写"},
+		validators.CodeFieldsSet:               {Other: "不能与{other}同时填写"},
+		validators.CodeGroupNotExactlyOne:      {Other: "{fields}中必须有且只有一项"},
+		validators.CodeGroupNonePresent:        {Other: "{fields}中至少需要一项"},
+		validators.CodeGroupManyPresent:        {Other: "{fields}中最多只能有一项"},
+		validators.CodeGroupMissingRequired:    {Other: "填写{with}时必须填写{fields}"},
+		validators.CodeMissing:                 {Other: "必须填写"},
+		validators.CodeEmpty:                   {Other: "不能为空"},
+		validators.CodeZero:                    {Other: "不能为零值"},
+	},
+}
+
+// German is the bundle of the built-in validators in German.
+var German = &Bundle{
+	Locale: "de",
+	Plural: pluralOneOther,
+	Messages: map[string]Message{
+		validators.CodeNil:                     {Other: "darf nicht nil sein"},
+		validators.CodeNotOdd:                  {Other: "muss ungerade sein"},
+		validators.CodeNotEven:                 {Other: "muss gerade sein"},
+		validators.CodeNotGreaterThan:          {Other: "muss größer als {target} sein"},
+		validators.CodeNotGreaterThanOrEqualTo: {Other: "muss größer oder gleich {target} sein"},
+		validators.CodeNotEqualTo:              {Other: "muss gleich {target} sein"},
+		validators.CodeNotLessThan:             {Other: "muss kleiner als {target} sein"},
+		validators.CodeNotLessThanOrEqualTo:    {Other: "muss kleiner oder gleich {target} sein"},
+		validators.CodeOutOfLeftRange:          {Other: "muss größer oder gleich {left} sein"},
+		validators.CodeOutOfRightRange:         {Other: "muss kleiner oder gleich {right} sein"},
+		validators.CodeStringBlank:             {Other: "darf nicht leer sein"},
+		validators.CodeStringNotIncluded:       {Other: "muss einer der Werte {in} sein"},
+		validators.CodeStringExcluded:          {Other: "darf keiner der Werte {in} sein"},
+		validators.CodeStringTooShort: {
+			Count: "min",
+			One:   "ist zu kurz (mindestens {min} Zeichen)",
+			Other: "ist zu kurz (mindestens {min} Zeichen)",
+		},
+		validators.CodeStringTooLong: {
+			Count: "max",
+			One:   "ist zu lang (höchstens {max} Zeichen)",
+			Other: "ist zu lang (höchstens {max} Zeichen)",
+		},
+		validators.CodeNaN:            {Other: "muss eine Zahl sein"},
+		validators.CodeNotFinite:      {Other: "muss endlich sein"},
+		validators.CodeNotApproxEqual: {Other: "muss ungefähr gleich {target} sein"},
+		validators.CodeBelowRange:     {Other: "muss größer oder gleich {min} sein"},
+		validators.CodeAboveRange:     {Other: "muss kleiner oder gleich {max} sein"},
+		validators.CodeTooManyDecimalPlaces: {
+			Count: "places",
+			One:   "darf höchstens {places} Nachkommastelle haben",
+			Other: "darf höchstens {places} Nachkommastellen haben",
+		},
+		validators.CodeStringNotMatched:        {Other: "muss dem Muster {pattern} entsprechen"},
+		validators.CodeStringMatched:           {Other: "darf dem Muster {pattern} nicht entsprechen"},
+		validators.CodeEmailMalformed:          {Other: "muss eine E-Mail-Adresse sein"},
+		validators.CodeEmailDomainNotAllowed:   {Other: "die E-Mail-Domain {domain} ist nicht erlaubt"},
+		validators.CodeEmailTooLong:            {Other: "E-Mail-Adresse ist zu lang (höchstens {max} Zeichen)"},
+		validators.CodeURLMalformed:            {Other: "muss eine URL sein"},
+		validators.CodeURLNotAbsolute:          {Other: "muss eine absolute URL sein"},
+		validators.CodeURLSchemeNotAllowed:     {Other: "URL-Schema muss eines von {schemes} sein"},
+		validators.CodeURLUserinfoForbidden:    {Other: "URL darf keine Benutzerinformationen enthalten"},
+		validators.CodeURLIPHostForbidden:      {Other: "URL-Host darf keine IP-Adresse sein"},
+		validators.CodeURLPrivateHostForbidden: {Other: "URL-Host darf kein privater Host sein"},
+		validators.CodeURLTooLong:              {Other: "URL ist zu lang (höchstens {max} Zeichen)"},
+		validators.CodeURLQueryRequired:        {Other: "URL muss eine Query enthalten"},
+		validators.CodeURLQueryForbidden:       {Other: "URL darf keine Query enthalten"},
+		validators.CodeURLFragmentRequired:     {Other: "URL muss ein Fragment enthalten"},
+		validators.CodeURLFragmentForbidden:    {Other: "URL darf kein Fragment enthalten"},
+		validators.CodeIPMalformed:             {Other: "muss eine IP-Adresse sein"},
+		validators.CodeIPWrongVersion:          {Other: "muss eine IPv{version}-Adresse sein"},
+		validators.CodeIPNotInPrefixes:         {Other: "muss in einem der Netze {prefixes} liegen"},
+		validators.CodeIPSpecial:               {Other: "darf keine spezielle IP-Adresse ({range}) sein"},
+		validators.CodeCIDRMalformed:           {Other: "muss ein CIDR-Präfix sein"},
+		validators.CodeCIDRWrongVersion:        {Other: "muss ein IPv{version}-CIDR-Präfix sein"},
+		validators.CodeCIDRTooShort:            {Other: "Präfixlänge muss mindestens {min} sein"},
+		validators.CodeCIDRTooLong:             {Other: "Präfixlänge darf höchstens {max} sein"},
+		validators.CodeCIDRNotMasked:           {Other: "darf keine Host-Bits gesetzt haben, verwende {masked}"},
+		validators.CodeUUIDMalformed:           {Other: "muss eine UUID sein"},
+		validators.CodeUUIDWrongVersion:        {Other: "muss eine UUID der Version {version} sein"},
+		validators.CodeUUIDWrongVariant:        {Other: "muss eine UUID der RFC-9562-Variante sein"},
+		validators.CodeULIDMalformed:           {Other: "muss eine ULID sein"},
+		validators.CodeULIDTimeOutOfRange:      {Other: "ULID-Zeit liegt außerhalb des Bereichs"},
+		validators.CodeTimeNotBefore:           {Other: "muss vor {target} liegen"},
+		validators.CodeTimeNotAfter:            {Other: "muss nach {target} liegen"},
+		validators.CodeTimeTooEarly:            {Other: "ist zu früh"},
+		validators.CodeTimeTooLate:             {Other: "ist zu spät"},
+		validators.CodeTimeZero:                {Other: "darf nicht leer sein"},
+		validators.CodeTimeNotInFuture:         {Other: "muss in der Zukunft liegen"},
+		validators.CodeTimeNotInPast:           {Other: "muss in der Vergangenheit liegen"},
+		validators.CodeDurationTooShort:        {Other: "muss mindestens {min} sein"},
+		validators.CodeDurationTooLong:         {Other: "darf höchstens {max} sein"},
+		validators.CodeTimeMalformed:           {Other: "muss eine Zeit im Format {layouts} sein"},
+		validators.CodeSliceTooFew: {
+			Count: "min",
+			One:   "muss mindestens {min} Eintrag haben",
+			Other: "muss mindestens {min} Einträge haben",
+		},
+		validators.CodeSliceTooMany: {
+			Count: "max",
+			One:   "darf höchstens {max} Eintrag haben",
+			Other: "darf höchstens {max} Einträge haben",
+		},
+		validators.CodeSliceNotUnique:    {Other: "Einträge müssen eindeutig sein"},
+		validators.CodeSliceMissing:      {Other: "muss alle von {targets} enthalten"},
+		validators.CodeSliceContainsNone: {Other: "muss eines von {targets} enthalten"},
+		validators.CodeSliceNotSubset:    {Other: "Einträge müssen in {allowed} enthalten sein"},
+		validators.CodeMapMissingKeys:    {Other: "muss die Schlüssel {missing} enthalten"},
+		validators.CodeMapKeyNotAllowed:  {Other: "Schlüssel müssen in {allowed} enthalten sein"},
+		validators.CodeMapKeyNotMatched:  {Other: "Schlüssel müssen dem Muster {pattern} entsprechen"},
+		validators.CodeMapTooFew: {
+			Count: "min",
+			One:   "muss mindestens {min} Eintrag haben",
+			Other: "muss mindestens {min} Einträge haben",
+		},
+		validators.CodeMapTooMany: {
+			Count: "max",
+			One:   "darf höchstens {max} Eintrag haben",
+			Other: "darf höchstens {max} Einträge haben",
+		},
+		guard.CodeAnyOf:                        {Other: "muss eine der Regeln erfüllen"},
+		guard.CodeOneOfNone:                    {Other: "muss genau eine der Regeln erfüllen"},
+		guard.CodeOneOfMany:                    {Other: "darf nur eine der Regeln erfüllen"},
+		guard.CodeNot:                          {Other: "ist ungültig"},
+		validators.CodeFieldsNotEqual:          {Other: "muss mit {other} übereinstimmen"},
+		validators.CodeFieldsEqual:             {Other: "darf nicht mit {other} übereinstimmen"},
+		validators.CodeFieldsNotLess:           {Other: "muss kleiner als {other} sein"},
+		validators.CodeFieldsNotLessOrEqual:    {Other: "muss kleiner oder gleich {other} sein"},
+		validators.CodeFieldsNotGreater:        {Other: "muss größer als {other} sein"},
+		validators.CodeFieldsNotGreaterOrEqual: {Other: "muss größer oder gleich {other} sein"},
+		validators.CodeFieldsNotBothSet:        {Other: "muss zusammen mit {other} angegeben werden"},
+		validators.CodeFieldsSet:               {Other: "darf nicht zusammen mit {other} angegeben werden"},
+		validators.CodeGroupNotExactlyOne:      {Other: "genau eines von {fields} muss angegeben werden"},
+		validators.CodeGroupNonePresent:        {Other: "mindestens eines von {fields} muss angegeben werden"},
+		validators.CodeGroupManyPresent:        {Other: "höchstens eines von {fields} darf angegeben werden"},
+		validators.CodeGroupMissingRequired:    {Other: "{fields} muss zusammen mit {with} angegeben werden"},
+		validators.CodeMissing:                 {Other: "muss angegeben werden"},
+		validators.CodeEmpty:                   {Other: "darf nicht leer sein"},
+		validators.CodeZero:                    {Other: "darf nicht null sein"},
+	},
+}
+
+// pluralOneOther is the plural rule of languages like English and German.
+func pluralOneOther(n int) Plural {
+	if n == 1 {
+		return One
+	}
+	return Other
+}
+
+// pluralOther is the plural rule of languages without plural forms, like Chinese.
+func pluralOther(n int) Plural {
+	return Other
+}
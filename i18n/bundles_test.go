@@ -0,0 +1,20 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/nauyey/guard/i18n"
+)
+
+func TestBundles(t *testing.T) {
+	for _, b := range []*i18n.Bundle{i18n.Chinese, i18n.German} {
+		for code := range i18n.English.Messages {
+			if _, ok := b.Messages[code]; !ok {
+				t.Errorf("i18n bundle %q failed with missing message of code %q", b.Locale, code)
+			}
+		}
+		if len(b.Messages) != len(i18n.English.Messages) {
+			t.Errorf("i18n bundle %q failed with %d messages, want %d messages", b.Locale, len(b.Messages), len(i18n.English.Messages))
+		}
+	}
+}
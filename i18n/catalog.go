@@ -0,0 +1,295 @@
+// Package i18n translates validation errors into localized messages.
+//
+// Messages are looked up by the codes of validation errors which implement
+// interface guard.CodedError, like the errors of the built-in validators.
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nauyey/guard"
+)
+
+// Plural is a plural category of a number.
+type Plural int
+
+// plural categories
+const (
+	Other Plural = iota
+	Zero
+	One
+	Two
+	Few
+	Many
+)
+
+// Message is a localized validation error message.
+//
+// A message is a template. Placeholders like "{min}" are replaced by the parameters
+// of the validation error. Parameters of type time.Time are formatted by RFC 3339,
+// and string slices are joined by ", ".
+//
+// If field Count names a parameter, the plural form of the parameter is selected by
+// the plural rule of the bundle. Field Other is used if the plural form is empty.
+type Message struct {
+	Count string
+
+	Zero  string
+	One   string
+	Two   string
+	Few   string
+	Many  string
+	Other string
+}
+
+func (m *Message) form(p Plural) string {
+	var s string
+	switch p {
+	case Zero:
+		s = m.Zero
+	case One:
+		s = m.One
+	case Two:
+		s = m.Two
+	case Few:
+		s = m.Few
+	case Many:
+		s = m.Many
+	}
+	if s == "" {
+		return m.Other
+	}
+	return s
+}
+
+// Bundle is the messages of a locale keyed by validation error codes.
+type Bundle struct {
+	Locale   string
+	Plural   func(n int) Plural
+	Messages map[string]Message
+}
+
+// Catalog is a set of bundles keyed by locales.
+type Catalog struct {
+	mu      sync.RWMutex
+	bundles map[string]*Bundle
+}
+
+// NewCatalog returns a catalog which contains bundles.
+func NewCatalog(bundles ...*Bundle) *Catalog {
+	c := &Catalog{bundles: map[string]*Bundle{}}
+	for _, b := range bundles {
+		c.Add(b)
+	}
+	return c
+}
+
+// Add adds a bundle to the catalog.
+// Messages of a bundle with the same locale are merged, and the new ones win.
+func (c *Catalog) Add(b *Bundle) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	locale := normalize(b.Locale)
+	old, ok := c.bundles[locale]
+	if !ok {
+		c.bundles[locale] = b
+		return
+	}
+
+	merged := &Bundle{Locale: b.Locale, Plural: b.Plural, Messages: map[string]Message{}}
+	if merged.Plural == nil {
+		merged.Plural = old.Plural
+	}
+	for code, m := range old.Messages {
+		merged.Messages[code] = m
+	}
+	for code, m := range b.Messages {
+		merged.Messages[code] = m
+	}
+	c.bundles[locale] = merged
+}
+
+// Message returns the localized message of a validation error.
+//
+// The bundle of the locale is used, like "de-AT", or the bundle of its language, like "de".
+// If err doesn't implement interface guard.CodedError or there isn't a message for its code,
+// ok will be false. If the message of err is overridden, like by OverrideMessage of the
+// built-in validators, ok will be false, too.
+func (c *Catalog) Message(locale string, err error) (msg string, ok bool) {
+	var cErr guard.CodedError
+	if !errors.As(err, &cErr) {
+		return "", false
+	}
+	var oErr overridable
+	if errors.As(err, &oErr) && oErr.Overridden() {
+		return "", false
+	}
+
+	b := c.bundle(locale)
+	if b == nil {
+		return "", false
+	}
+	m, ok := b.Messages[cErr.Code()]
+	if !ok {
+		return "", false
+	}
+
+	p := Other
+	if n, ok := toInt(cErr.Params()[m.Count]); ok && m.Count != "" && b.Plural != nil {
+		p = b.Plural(n)
+	}
+	return format(m.form(p), cErr.Params()), true
+}
+
+// Translate translates the validation errors of err into the locale.
+//
+// If err implements interface guard.Errors, every validation error of it will be translated.
+// The validation errors without localized messages and the non-validation errors stay unchanged.
+// A translated error wraps the original one, and implements interface guard.CodedError,
+// and guard.FieldError if the original one does, so its field path and code are kept.
+func (c *Catalog) Translate(locale string, err error) error {
+	switch vErr := err.(type) {
+	default:
+		return err
+	case guard.Errors:
+		errs := make([]error, 0, len(vErr.ValidationErrors()))
+		for _, e := range vErr.ValidationErrors() {
+			errs = append(errs, c.translate(locale, e))
+		}
+		return &translatedErrors{errs: errs}
+	case guard.Error:
+		return c.translate(locale, err)
+	}
+}
+
+func (c *Catalog) translate(locale string, err error) error {
+	msg, ok := c.Message(locale, err)
+	if !ok {
+		return err
+	}
+	var cErr guard.CodedError
+	errors.As(err, &cErr)
+	tErr := &translatedError{msg: msg, err: err, coded: cErr}
+	if fErr, ok := err.(guard.FieldError); ok {
+		return &translatedFieldError{translatedError: tErr, field: fErr.Field()}
+	}
+	return tErr
+}
+
+// overridable is the interface of the validation errors whose messages may be overridden.
+type overridable interface {
+	Overridden() bool
+}
+
+func (c *Catalog) bundle(locale string) *Bundle {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	locale = normalize(locale)
+	if b, ok := c.bundles[locale]; ok {
+		return b
+	}
+	if i := strings.IndexByte(locale, '-'); i > 0 {
+		return c.bundles[locale[:i]]
+	}
+	return nil
+}
+
+func normalize(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+}
+
+func format(tmpl string, params map[string]interface{}) string {
+	if len(params) == 0 || !strings.Contains(tmpl, "{") {
+		return tmpl
+	}
+
+	pairs := make([]string, 0, 2*len(params))
+	for k, v := range params {
+		pairs = append(pairs, "{"+k+"}", formatParam(v))
+	}
+	return strings.NewReplacer(pairs...).Replace(tmpl)
+}
+
+func formatParam(v interface{}) string {
+	switch v := v.(type) {
+	case []string:
+		return strings.Join(v, ", ")
+	case time.Time:
+		// RFC 3339 without the monotonic clock reading of fmt.Sprint
+		return v.Round(0).Format(time.RFC3339)
+	}
+	return fmt.Sprint(v)
+}
+
+func toInt(v interface{}) (int, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return int(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return int(rv.Float()), true
+	}
+	return 0, false
+}
+
+type translatedError struct {
+	msg   string
+	err   error
+	coded guard.CodedError
+}
+
+// Error implements the error interface
+func (err *translatedError) Error() string {
+	return err.msg
+}
+
+// ValidationError implements the guard.Error interface
+func (err *translatedError) ValidationError() {}
+
+// Code implements the guard.CodedError interface
+func (err *translatedError) Code() string {
+	return err.coded.Code()
+}
+
+// Params implements the guard.CodedError interface
+func (err *translatedError) Params() map[string]interface{} {
+	return err.coded.Params()
+}
+
+// Unwrap returns the original validation error
+func (err *translatedError) Unwrap() error {
+	return err.err
+}
+
+type translatedFieldError struct {
+	*translatedError
+	field string
+}
+
+// Field implements the guard.FieldError interface
+func (err *translatedFieldError) Field() string {
+	return err.field
+}
+
+type translatedErrors struct {
+	errs []error
+}
+
+// Error implements the error interface
+func (err *translatedErrors) Error() string {
+	return "validation errors"
+}
+
+// ValidationErrors implements the guard.Errors interface
+func (err *translatedErrors) ValidationErrors() []error {
+	return err.errs
+}
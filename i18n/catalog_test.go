@@ -0,0 +1,109 @@
+package i18n_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nauyey/guard"
+	"github.com/nauyey/guard/i18n"
+	"github.com/nauyey/guard/validators"
+)
+
+func TestTranslate(t *testing.T) {
+	err := guard.Validate(
+		guard.Field("name", &validators.StringNotBlank{Value: " "}),
+		&validators.StringLength{Value: "ab", Min: 3, Max: 5},
+		&validators.StringLength{Value: "", Min: 1, Max: 5},
+	)
+
+	tests := []struct {
+		locale string
+		msgs   []string
+	}{
+		{"en", []string{"shouldn't be blank", "is too short (minimum is 3 characters)", "is too short (minimum is 1 character)"}},
+		{"zh-CN", []string{"不能为空白", "过短（最少3个字符）", "过短（最少1个字符）"}},
+		{"de_AT", []string{"darf nicht leer sein", "ist zu kurz (mindestens 3 Zeichen)", "ist zu kurz (mindestens 1 Zeichen)"}},
+		{"fr", []string{"shouldn't be blank", "too short", "too short"}},
+	}
+
+	for _, test := range tests {
+		errs, ok := i18n.Translate(test.locale, err).(guard.Errors)
+		if !ok {
+			t.Fatalf("i18n.Translate failed to return err(type guard.Errors)")
+		}
+		vErrs := errs.ValidationErrors()
+		if len(vErrs) != len(test.msgs) {
+			t.Fatalf("i18n.Translate failed with len(vErrs)=%d, want len(vErrs)=%d", len(vErrs), len(test.msgs))
+		}
+		for i, msg := range test.msgs {
+			if vErrs[i].Error() != msg {
+				t.Errorf("i18n.Translate(%q) failed with message=%q, want message=%q", test.locale, vErrs[i].Error(), msg)
+			}
+		}
+
+		// test keeping field paths and codes
+		if fErr, ok := vErrs[0].(guard.FieldError); !ok || fErr.Field() != "name" {
+			t.Errorf("i18n.Translate(%q) failed to keep field path", test.locale)
+		}
+		if cErr, ok := vErrs[1].(guard.CodedError); !ok || cErr.Code() != validators.CodeStringTooShort || cErr.Params()["min"] != 3 {
+			t.Errorf("i18n.Translate(%q) failed to keep error code", test.locale)
+		}
+		if _, ok := vErrs[1].(guard.FieldError); ok {
+			t.Errorf("i18n.Translate(%q) failed with field path of error without field", test.locale)
+		}
+	}
+
+	// test keeping overridden messages
+	err = guard.Validate(
+		guard.Field("name", (&validators.StringNotBlank{Value: ""}).OverrideMessage("please tell us your name")),
+	)
+	errs, ok := i18n.Translate("de", err).(guard.Errors)
+	if !ok {
+		t.Fatalf("i18n.Translate failed to return err(type guard.Errors)")
+	}
+	if msg := errs.ValidationErrors()[0].Error(); msg != "please tell us your name" {
+		t.Errorf("i18n.Translate failed to keep overridden message with message=%q", msg)
+	}
+
+	// test keeping the messages of guard.Not
+	err = guard.Validate(guard.Not(&validators.StringInclusion{Value: "admin", In: []string{"admin"}}, "is reserved"))
+	if msg := i18n.Translate("en", err).(guard.Errors).ValidationErrors()[0].Error(); msg != "is reserved" {
+		t.Errorf("i18n.Translate failed to keep the message of guard.Not with message=%q", msg)
+	}
+
+	// test formatting time params
+	target := time.Now().Add(-time.Hour)
+	err = (&validators.TimeBefore{Value: time.Now(), Target: target}).Validate()
+	if msg, want := i18n.Translate("en", err).Error(), "should be before "+target.Format(time.RFC3339); msg != want {
+		t.Errorf("i18n.Translate failed with message=%q, want message=%q", msg, want)
+	}
+
+	// test with non-validation errors
+	internal := errors.New("non-validation error")
+	if err := i18n.Translate("en", internal); err != internal {
+		t.Errorf("i18n.Translate failed to return non-validation error")
+	}
+}
+
+func TestCatalogAdd(t *testing.T) {
+	catalog := i18n.NewCatalog(i18n.English)
+	catalog.Add(&i18n.Bundle{
+		Locale: "en",
+		Messages: map[string]i18n.Message{
+			validators.CodeStringBlank: {Other: "can't be blank"},
+		},
+	})
+
+	msg, ok := catalog.Message("en-US", (&validators.StringNotBlank{}).Validate())
+	if !ok || msg != "can't be blank" {
+		t.Errorf("i18n.Catalog.Add failed with message=%q", msg)
+	}
+	msg, ok = catalog.Message("en-US", (&validators.StringLength{Value: "abc", Max: 2}).Validate())
+	if !ok || msg != "is too long (maximum is 2 characters)" {
+		t.Errorf("i18n.Catalog.Add failed with message=%q", msg)
+	}
+	if _, ok = catalog.Message("zh", (&validators.StringNotBlank{}).Validate()); ok {
+		t.Errorf("i18n.Catalog.Message failed with unknown locale")
+	}
+}
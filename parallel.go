@@ -0,0 +1,122 @@
+package guard
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// ValidateParallel executes the validators concurrently by a pool of at most workers goroutines.
+// If workers is less than 1, runtime.GOMAXPROCS(0) goroutines will be used.
+//
+// It works the same as ValidateContext, except:
+//
+// The validation errors are still returned in the order the validators are declared,
+// no matter which validator finishes first.
+//
+// Strict validators are barriers. A strict validator is executed after all the validators
+// declared before it finished. If it's invalid, ValidateParallel stops executing validators
+// and returns the validation errors collected so far.
+//
+// If a validator faild by internal error, the first observed internal error is returned,
+// and the context passed to the other running validators is canceled.
+func ValidateParallel(ctx context.Context, workers int, validators ...Validator) error {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := []error{}
+	start := 0
+	for i, v := range validators {
+		if _, ok := v.(*strictValidators); !ok {
+			continue
+		}
+
+		var err error
+		if errs, err = validateGroup(ctx, cancel, workers, errs, validators[start:i]); err != nil {
+			return err
+		}
+		start = i + 1
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := validate(ctx, v); err != nil {
+			if errs, err = collect(errs, err); err != nil {
+				return err
+			}
+			return &errors{errs: errs}
+		}
+	}
+
+	var err error
+	if errs, err = validateGroup(ctx, cancel, workers, errs, validators[start:]); err != nil {
+		return err
+	}
+
+	if len(errs) != 0 {
+		return &errors{errs: errs}
+	}
+	return nil
+}
+
+// validateGroup executes the non-strict validators concurrently,
+// then collects their validation errors into errs in declaration order.
+func validateGroup(ctx context.Context, cancel context.CancelFunc, workers int, errs []error, validators []Validator) ([]error, error) {
+	if len(validators) == 0 {
+		return errs, nil
+	}
+	if workers > len(validators) {
+		workers = len(validators)
+	}
+
+	var (
+		results  = make([]error, len(validators))
+		indexes  = make(chan int)
+		wg       sync.WaitGroup
+		once     sync.Once
+		internal error
+	)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if ctx.Err() != nil {
+					continue
+				}
+				err := validate(ctx, validators[i])
+				if _, iErr := collect(nil, err); iErr != nil {
+					once.Do(func() {
+						internal = iErr
+						cancel()
+					})
+					continue
+				}
+				results[i] = err
+			}
+		}()
+	}
+
+	for i := range validators {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	if internal != nil {
+		return errs, internal
+	}
+	if err := ctx.Err(); err != nil {
+		return errs, err
+	}
+
+	for _, err := range results {
+		errs, _ = collect(errs, err)
+	}
+	return errs, nil
+}
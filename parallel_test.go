@@ -0,0 +1,110 @@
+package guard_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nauyey/guard"
+)
+
+type sleepValidator struct {
+	delay   time.Duration
+	err     error
+	running *int32
+	max     *int32
+}
+
+func (v *sleepValidator) Validate() error {
+	n := atomic.AddInt32(v.running, 1)
+	defer atomic.AddInt32(v.running, -1)
+	for {
+		max := atomic.LoadInt32(v.max)
+		if n <= max || atomic.CompareAndSwapInt32(v.max, max, n) {
+			break
+		}
+	}
+
+	time.Sleep(v.delay)
+	return v.err
+}
+
+func TestValidateParallel(t *testing.T) {
+	var running, max int32
+	validators := []guard.Validator{}
+	for i := 0; i < 8; i++ {
+		validators = append(validators, &sleepValidator{
+			delay:   time.Duration(8-i) * time.Millisecond,
+			err:     &validationError{msg: string(rune('a' + i))},
+			running: &running,
+			max:     &max,
+		})
+	}
+
+	err := guard.ValidateParallel(context.Background(), 3, validators...)
+	errs, ok := err.(guard.Errors)
+	if !ok {
+		t.Fatalf("guard.ValidateParallel failed to return err(type guard.Errors)")
+	}
+	vErrs := errs.ValidationErrors()
+	if len(vErrs) != 8 {
+		t.Fatalf("guard.ValidateParallel failed with len(vErrs)=%d, want len(vErrs)=8", len(vErrs))
+	}
+	for i, err := range vErrs {
+		if err.Error() != string(rune('a'+i)) {
+			t.Errorf("guard.ValidateParallel failed to keep declaration order at %d, got %q", i, err.Error())
+		}
+	}
+	if max > 3 {
+		t.Errorf("guard.ValidateParallel failed with %d running validators, want at most 3", max)
+	}
+
+	// test without validation errors
+	err = guard.ValidateParallel(context.Background(), 0, &testValidator{}, guard.Strict(&testValidator{}), &testValidator{})
+	if err != nil {
+		t.Errorf("guard.ValidateParallel failed with err=%v", err)
+	}
+}
+
+func TestValidateParallelStrictly(t *testing.T) {
+	var running, max int32
+	after := &testContextValidator{}
+	err := guard.ValidateParallel(context.Background(), 4,
+		&sleepValidator{delay: time.Millisecond, err: &validationError{}, running: &running, max: &max},
+		&testValidator{},
+		guard.Strict(&testValidator{err: &validationError{}}),
+		after,
+	)
+	errs, ok := err.(guard.Errors)
+	if !ok {
+		t.Fatalf("guard.ValidateParallel failed to return err(type guard.Errors)")
+	}
+	if len(errs.ValidationErrors()) != 2 {
+		t.Errorf("guard.ValidateParallel failed with len(vErrs)=%d, want len(vErrs)=2", len(errs.ValidationErrors()))
+	}
+	if after.ctx != nil {
+		t.Errorf("guard.ValidateParallel failed to stop at strict validator")
+	}
+}
+
+func TestValidateParallelInternalError(t *testing.T) {
+	internal := errors.New("non-validation error")
+	err := guard.ValidateParallel(context.Background(), 2,
+		&testValidator{err: &validationError{}},
+		&testValidator{err: internal},
+		&testValidator{err: &validationError{}},
+	)
+	if err != internal {
+		t.Errorf("guard.ValidateParallel failed with err=%v, want err=%v", err, internal)
+	}
+
+	// test with canceled ctx
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = guard.ValidateParallel(ctx, 2, &testValidator{}, &testValidator{})
+	if err != context.Canceled {
+		t.Errorf("guard.ValidateParallel failed with err=%v, want err=%v", err, context.Canceled)
+	}
+}
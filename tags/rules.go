@@ -0,0 +1,118 @@
+package tags
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Rule is a validation rule of a guard tag, like "notblank" or "len=3..20".
+//
+// Name is the name of the rule, and Param is the raw parameter after "=", or "" if there isn't one.
+type Rule struct {
+	Name  string
+	Param string
+}
+
+// the names of the supported rules and whether they take a parameter
+var ruleParams = map[string]bool{
+	"notnil":   false,
+	"notblank": false,
+	"email":    false,
+	"url":      false,
+	"uuid":     false,
+	"len":      true,
+	"in":       true,
+	"notin":    true,
+	"min":      true,
+	"max":      true,
+	"match":    true,
+}
+
+// Parse parses the rules of a guard tag, like "notblank,len=3..20,in=female|male|other".
+//
+// The rules are separated by commas, so a parameter can't contain a comma.
+// The supported rules are:
+//
+//	notnil          the pointer, interface, slice, map, chan or func isn't nil (validators.NotNil)
+//	notblank        the string isn't blank (validators.StringNotBlank)
+//	len=MIN..MAX    the length of the string or slice is in range, either bound may be omitted,
+//	                and len=N means exactly N (validators.StringLength, MinItems and MaxItems)
+//	in=A|B|C        the string is one of the values (validators.StringInclusion)
+//	notin=A|B|C     the string isn't any of the values (validators.StringExclusion)
+//	min=N, max=N    the number is greater/less than or equal to N (validators.GreaterThanOrEqualTo and LessThanOrEqualTo)
+//	match=PATTERN   the string matches the regular expression (validators.StringMatches)
+//	email, url, uuid
+//	                the string is an email address, URL or UUID (validators.Email, URL and UUID)
+//
+// Parse returns an error if a rule is unknown or its parameter is malformed.
+func Parse(tag string) ([]Rule, error) {
+	if strings.TrimSpace(tag) == "" {
+		return nil, nil
+	}
+
+	rules := []Rule{}
+	for _, s := range strings.Split(tag, ",") {
+		name, param, hasParam := strings.Cut(s, "=")
+		r := Rule{Name: strings.TrimSpace(name), Param: param}
+
+		takesParam, ok := ruleParams[r.Name]
+		if !ok {
+			return nil, fmt.Errorf("guard tag %q: unknown rule %q", tag, r.Name)
+		}
+		if takesParam != hasParam {
+			if takesParam {
+				return nil, fmt.Errorf("guard tag %q: rule %q requires a parameter", tag, r.Name)
+			}
+			return nil, fmt.Errorf("guard tag %q: rule %q doesn't take a parameter", tag, r.Name)
+		}
+		if err := r.check(); err != nil {
+			return nil, fmt.Errorf("guard tag %q: rule %q: %v", tag, r.Name, err)
+		}
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+// Bounds returns the bounds of a range parameter, like "3..20".
+// An omitted bound is returned as "". A single number, like "5", is both bounds.
+func (r Rule) Bounds() (min, max string) {
+	if min, max, ok := strings.Cut(r.Param, ".."); ok {
+		return strings.TrimSpace(min), strings.TrimSpace(max)
+	}
+	return strings.TrimSpace(r.Param), strings.TrimSpace(r.Param)
+}
+
+// Values returns the values of a list parameter, like "female|male|other".
+func (r Rule) Values() []string {
+	return strings.Split(r.Param, "|")
+}
+
+func (r Rule) check() error {
+	switch r.Name {
+	case "len":
+		min, max := r.Bounds()
+		if min == "" && max == "" {
+			return fmt.Errorf("range %q has no bound", r.Param)
+		}
+		for _, b := range []string{min, max} {
+			if n, err := strconv.Atoi(b); b != "" && (err != nil || n < 0) {
+				return fmt.Errorf("malformed length %q", b)
+			}
+		}
+	case "min", "max":
+		if _, err := strconv.ParseFloat(r.Param, 64); err != nil {
+			return fmt.Errorf("malformed number %q", r.Param)
+		}
+	case "in", "notin":
+		if r.Param == "" {
+			return fmt.Errorf("empty list")
+		}
+	case "match":
+		if _, err := regexp.Compile(r.Param); err != nil {
+			return err
+		}
+	}
+	return nil
+}
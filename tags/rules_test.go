@@ -0,0 +1,68 @@
+package tags_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nauyey/guard/tags"
+)
+
+func TestParse(t *testing.T) {
+	rules, err := tags.Parse("notblank,len=3..20,in=female|male|other")
+	if err != nil {
+		t.Fatalf("tags.Parse failed with err=%v", err)
+	}
+	want := []tags.Rule{
+		{Name: "notblank"},
+		{Name: "len", Param: "3..20"},
+		{Name: "in", Param: "female|male|other"},
+	}
+	if !reflect.DeepEqual(rules, want) {
+		t.Errorf("tags.Parse failed with rules=%v, want rules=%v", rules, want)
+	}
+
+	if rules, err := tags.Parse(""); err != nil || len(rules) != 0 {
+		t.Errorf("tags.Parse failed with rules=%v, err=%v", rules, err)
+	}
+
+	for _, tag := range []string{
+		"unknown",
+		"notblank=1",
+		"len",
+		"len=..",
+		"len=a..3",
+		"len=-1",
+		"min=x",
+		"in=",
+		"match=(",
+	} {
+		if _, err := tags.Parse(tag); err == nil {
+			t.Errorf("tags.Parse failed to return err with tag=%q", tag)
+		}
+	}
+}
+
+func TestRuleBounds(t *testing.T) {
+	tests := []struct {
+		param    string
+		min, max string
+	}{
+		{"3..20", "3", "20"},
+		{"3..", "3", ""},
+		{"..20", "", "20"},
+		{"5", "5", "5"},
+	}
+	for _, test := range tests {
+		min, max := tags.Rule{Name: "len", Param: test.param}.Bounds()
+		if min != test.min || max != test.max {
+			t.Errorf("tags.Rule.Bounds failed with param=%q, min=%q, max=%q", test.param, min, max)
+		}
+	}
+}
+
+func TestRuleValues(t *testing.T) {
+	values := tags.Rule{Name: "in", Param: "female|male|other"}.Values()
+	if !reflect.DeepEqual(values, []string{"female", "male", "other"}) {
+		t.Errorf("tags.Rule.Values failed with values=%v", values)
+	}
+}
@@ -0,0 +1,417 @@
+// Package tags validates structs by their guard tags, like
+//
+//	type User struct {
+//		Name   string `json:"name" guard:"notblank,len=3..20"`
+//		Gender string `json:"gender" guard:"in=female|male|other"`
+//	}
+//
+// The rules of a tag are built into the built-in validators of package validators,
+// so the validation errors are the same as the ones of a hand-written Validate method.
+//
+// Package tags is opt-in. It uses reflection, but the core package guard stays reflection-free.
+// The plan of a struct type, which fields to validate by which rules, is built once per type and cached.
+package tags
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/nauyey/guard"
+	"github.com/nauyey/guard/validators"
+)
+
+// Validate validates the struct v, or the struct v points to, by its guard tags.
+//
+// It's a shortcut of guard.Validate(tags.Struct(v)).
+func Validate(v interface{}) error {
+	return guard.Validate(Struct(v))
+}
+
+// Struct returns a validator which validates the struct v, or the struct v points to, by its guard tags.
+//
+// The validation errors of a field are bound to the field path, which is the name of its json tag,
+// or the name of the field if there isn't one. The fields of embedded structs are promoted.
+//
+// The fields implementing interface guard.Validator, like nested structs with Validate methods,
+// are validated by their own Validate methods. A nil pointer of them is valid, use rule "notnil" to require it.
+// The other nested structs and the elements of slices are validated by their guard tags, too.
+// The Validate method of v itself isn't called, so a Validate method can be implemented by tags.Validate.
+//
+// If v isn't a struct or a pointer to struct, or a guard tag is malformed,
+// the validator returns an error which isn't a validation error.
+func Struct(v interface{}) guard.Validator {
+	return &structValidator{value: reflect.ValueOf(v)}
+}
+
+type structValidator struct {
+	value reflect.Value
+}
+
+// Validate implements the guard.Validator interface
+func (v *structValidator) Validate() error {
+	return v.ValidateContext(context.Background())
+}
+
+// ValidateContext implements the guard.ContextValidator interface
+func (v *structValidator) ValidateContext(ctx context.Context) error {
+	sv := v.value
+	if !sv.IsValid() {
+		return fmt.Errorf("tags: validate nil")
+	}
+	for sv.Kind() == reflect.Ptr || sv.Kind() == reflect.Interface {
+		if sv.IsNil() {
+			return fmt.Errorf("tags: validate nil %s", sv.Type())
+		}
+		sv = sv.Elem()
+	}
+	if sv.Kind() != reflect.Struct {
+		return fmt.Errorf("tags: validate non-struct %s", v.value.Type())
+	}
+	if !sv.CanAddr() {
+		// copy the struct, so that the Validate methods of pointer receivers can be called
+		cp := reflect.New(sv.Type()).Elem()
+		cp.Set(sv)
+		sv = cp
+	}
+
+	p, err := planOf(sv.Type())
+	if err != nil {
+		return err
+	}
+	return guard.ValidateContext(ctx, p.validators(sv)...)
+}
+
+// plans caches the plans of struct types.
+var plans sync.Map
+
+// plan is the way to validate a struct type.
+type plan struct {
+	fields []*fieldPlan
+	err    error
+}
+
+// fieldPlan is the way to validate a field of a struct type.
+type fieldPlan struct {
+	index    int
+	name     string
+	embedded bool
+	notNil   bool
+	rules    []ruleFunc
+	nested   nestedKind
+}
+
+// ruleFunc builds the validator of a rule for a field value.
+// Pointers are dereferenced before, and nil pointers aren't passed to it.
+type ruleFunc func(fv reflect.Value) guard.Validator
+
+type nestedKind int
+
+const (
+	nestedNone nestedKind = iota
+	nestedValidator
+	nestedStruct
+	nestedElements
+)
+
+var validatorType = reflect.TypeOf((*guard.Validator)(nil)).Elem()
+
+func planOf(t reflect.Type) (*plan, error) {
+	if p, ok := plans.Load(t); ok {
+		return p.(*plan), p.(*plan).err
+	}
+
+	p := buildPlan(t)
+	actual, _ := plans.LoadOrStore(t, p)
+	return actual.(*plan), actual.(*plan).err
+}
+
+func buildPlan(t reflect.Type) *plan {
+	p := &plan{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("guard")
+		if tag == "-" {
+			continue
+		}
+		if !f.IsExported() {
+			// only the promoted fields of an unexported embedded struct can be validated
+			if f.Anonymous && f.Type.Kind() == reflect.Struct && tag == "" {
+				p.fields = append(p.fields, &fieldPlan{index: i, embedded: true, nested: nestedStruct})
+			}
+			continue
+		}
+
+		rules, err := Parse(tag)
+		if err != nil {
+			p.err = fmt.Errorf("tags: field %s.%s: %v", t.Name(), f.Name, err)
+			return p
+		}
+
+		name, hasName := jsonName(f)
+		fp := &fieldPlan{
+			index:    i,
+			name:     name,
+			embedded: f.Anonymous && !hasName,
+			nested:   nestedKindOf(f.Type),
+		}
+		for _, r := range rules {
+			if r.Name == "notnil" {
+				if !nilable(f.Type) {
+					p.err = fmt.Errorf("tags: field %s.%s: rule %q doesn't support type %s", t.Name(), f.Name, r.Name, f.Type)
+					return p
+				}
+				fp.notNil = true
+				continue
+			}
+			rf, err := buildRule(r, indirect(f.Type))
+			if err != nil {
+				p.err = fmt.Errorf("tags: field %s.%s: %v", t.Name(), f.Name, err)
+				return p
+			}
+			fp.rules = append(fp.rules, rf)
+		}
+		if len(rules) != 0 || fp.nested != nestedNone {
+			p.fields = append(p.fields, fp)
+		}
+	}
+	return p
+}
+
+func (p *plan) validators(sv reflect.Value) []guard.Validator {
+	vs := []guard.Validator{}
+	for _, fp := range p.fields {
+		fv := sv.Field(fp.index)
+		fvs := fp.validators(fv)
+		if fp.embedded {
+			vs = append(vs, fvs...)
+			continue
+		}
+		for _, v := range fvs {
+			vs = append(vs, guard.Field(fp.name, v))
+		}
+	}
+	return vs
+}
+
+func (fp *fieldPlan) validators(fv reflect.Value) []guard.Validator {
+	vs := []guard.Validator{}
+	if fp.notNil {
+		vs = append(vs, &validators.NotNil{Value: fv.Interface()})
+	}
+
+	if (fv.Kind() == reflect.Ptr || fv.Kind() == reflect.Interface) && fv.IsNil() {
+		return vs
+	}
+
+	ev := fv
+	if ev.Kind() == reflect.Ptr {
+		ev = ev.Elem()
+	}
+	for _, rf := range fp.rules {
+		vs = append(vs, rf(ev))
+	}
+
+	if v := nested(fp.nested, fv); v != nil {
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+// nested returns the validator of a nested value, or nil if there isn't one.
+func nested(kind nestedKind, fv reflect.Value) guard.Validator {
+	if (fv.Kind() == reflect.Ptr || fv.Kind() == reflect.Interface) && fv.IsNil() {
+		return nil
+	}
+
+	switch kind {
+	case nestedValidator:
+		if fv.Type().Implements(validatorType) {
+			return fv.Interface().(guard.Validator)
+		}
+		if fv.CanAddr() {
+			return fv.Addr().Interface().(guard.Validator)
+		}
+		// a copy of the value, so that the Validate method of the pointer receiver can be called
+		cp := reflect.New(fv.Type())
+		cp.Elem().Set(fv)
+		return cp.Interface().(guard.Validator)
+	case nestedStruct:
+		return &structValidator{value: fv}
+	case nestedElements:
+		elemKind := nestedKindOf(fv.Type().Elem())
+		vs := make([]guard.Validator, 0, fv.Len())
+		for i := 0; i < fv.Len(); i++ {
+			if v := nested(elemKind, fv.Index(i)); v != nil {
+				vs = append(vs, guard.Index(i, v))
+			}
+		}
+		return guard.AllOf(vs...)
+	}
+	return nil
+}
+
+func nestedKindOf(t reflect.Type) nestedKind {
+	if t.Implements(validatorType) || (t.Kind() != reflect.Ptr && reflect.PointerTo(t).Implements(validatorType)) {
+		return nestedValidator
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		if t.Elem().Kind() == reflect.Struct {
+			return nestedStruct
+		}
+	case reflect.Struct:
+		if t.NumField() != 0 {
+			return nestedStruct
+		}
+	case reflect.Slice, reflect.Array:
+		if nestedKindOf(t.Elem()) != nestedNone {
+			return nestedElements
+		}
+	}
+	return nestedNone
+}
+
+func buildRule(r Rule, t reflect.Type) (ruleFunc, error) {
+	switch r.Name {
+	case "notblank":
+		if t.Kind() == reflect.String {
+			return func(fv reflect.Value) guard.Validator {
+				return &validators.StringNotBlank{Value: fv.String()}
+			}, nil
+		}
+	case "email":
+		if t.Kind() == reflect.String {
+			return func(fv reflect.Value) guard.Validator {
+				return &validators.Email{Value: fv.String()}
+			}, nil
+		}
+	case "url":
+		if t.Kind() == reflect.String {
+			return func(fv reflect.Value) guard.Validator {
+				return &validators.URL{Value: fv.String()}
+			}, nil
+		}
+	case "uuid":
+		if t.Kind() == reflect.String {
+			return func(fv reflect.Value) guard.Validator {
+				return &validators.UUID{Value: fv.String()}
+			}, nil
+		}
+	case "match":
+		if t.Kind() == reflect.String {
+			return func(fv reflect.Value) guard.Validator {
+				return &validators.StringMatches{Value: fv.String(), Pattern: r.Param}
+			}, nil
+		}
+	case "in":
+		if t.Kind() == reflect.String {
+			in := r.Values()
+			return func(fv reflect.Value) guard.Validator {
+				return &validators.StringInclusion{Value: fv.String(), In: in}
+			}, nil
+		}
+	case "notin":
+		if t.Kind() == reflect.String {
+			in := r.Values()
+			return func(fv reflect.Value) guard.Validator {
+				return &validators.StringExclusion{Value: fv.String(), In: in}
+			}, nil
+		}
+	case "len":
+		return buildLen(r, t)
+	case "min", "max":
+		return buildBound(r, t)
+	}
+	return nil, fmt.Errorf("rule %q doesn't support type %s", r.Name, t)
+}
+
+func buildLen(r Rule, t reflect.Type) (ruleFunc, error) {
+	minS, maxS := r.Bounds()
+	min, max := 0, math.MaxInt
+	if minS != "" {
+		min, _ = strconv.Atoi(minS)
+	}
+	if maxS != "" {
+		max, _ = strconv.Atoi(maxS)
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return func(fv reflect.Value) guard.Validator {
+			return &validators.StringLength{Value: fv.String(), Min: min, Max: max}
+		}, nil
+	case reflect.Slice, reflect.Array:
+		return func(fv reflect.Value) guard.Validator {
+			// only the number of the items matters, and a slice of struct{} doesn't allocate
+			items := make([]struct{}, fv.Len())
+			return guard.AllOf(
+				&validators.MinItems[struct{}]{Values: items, Min: min},
+				&validators.MaxItems[struct{}]{Values: items, Max: max},
+			)
+		}, nil
+	}
+	return nil, fmt.Errorf("rule %q doesn't support type %s", r.Name, t)
+}
+
+func buildBound(r Rule, t reflect.Type) (ruleFunc, error) {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		target, err := strconv.ParseInt(r.Param, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: malformed integer %q", r.Name, r.Param)
+		}
+		return func(fv reflect.Value) guard.Validator {
+			return bound(r.Name, fv.Int(), target)
+		}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		target, err := strconv.ParseUint(r.Param, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: malformed unsigned integer %q", r.Name, r.Param)
+		}
+		return func(fv reflect.Value) guard.Validator {
+			return bound(r.Name, fv.Uint(), target)
+		}, nil
+	case reflect.Float32, reflect.Float64:
+		target, _ := strconv.ParseFloat(r.Param, 64)
+		return func(fv reflect.Value) guard.Validator {
+			return bound(r.Name, fv.Float(), target)
+		}, nil
+	}
+	return nil, fmt.Errorf("rule %q doesn't support type %s", r.Name, t)
+}
+
+func bound[T validators.Number](name string, value, target T) guard.Validator {
+	if name == "min" {
+		return &validators.GreaterThanOrEqualTo[T]{Value: value, Target: target}
+	}
+	return &validators.LessThanOrEqualTo[T]{Value: value, Target: target}
+}
+
+// jsonName returns the name of the json tag of f, or the name of f if there isn't one.
+func jsonName(f reflect.StructField) (string, bool) {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return f.Name, false
+	}
+	return name, true
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+	return t
+}
+
+func nilable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice, reflect.UnsafePointer:
+		return true
+	}
+	return false
+}
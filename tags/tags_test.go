@@ -0,0 +1,166 @@
+package tags_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/nauyey/guard"
+	"github.com/nauyey/guard/tags"
+	"github.com/nauyey/guard/validators"
+)
+
+type author struct {
+	Name string `json:"name" guard:"notblank"`
+}
+
+// Validate implements interface guard.Validator
+func (a *author) Validate() error {
+	return guard.Validate(
+		guard.Field("name", &validators.StringNotBlank{Value: a.Name}),
+	)
+}
+
+type chapter struct {
+	Title string `guard:"notblank"`
+}
+
+type Meta struct {
+	Tags []string `json:"tags" guard:"len=..2"`
+}
+
+type book struct {
+	Meta
+	Title    string    `json:"title,omitempty" guard:"notblank,len=3..20"`
+	Genre    string    `json:"genre" guard:"in=novel|poem"`
+	Pages    int       `json:"pages" guard:"min=1,max=1000"`
+	Price    *float64  `json:"price" guard:"min=0"`
+	ISBN     string    `json:"-" guard:"match=^[0-9-]+$"`
+	Author   *author   `json:"author" guard:"notnil"`
+	Editor   *author   `json:"editor"`
+	Chapters []chapter `json:"chapters"`
+	Skipped  string    `guard:"-"`
+	internal string
+}
+
+// errorsOf returns the field paths and codes of the validation errors of err.
+func errorsOf(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	errs, ok := err.(guard.Errors)
+	if !ok {
+		t.Fatalf("tags.Validate failed with err=%v", err)
+	}
+	got := []string{}
+	for _, vErr := range errs.ValidationErrors() {
+		var cErr guard.CodedError
+		errors.As(vErr, &cErr)
+		got = append(got, vErr.(guard.FieldError).Field()+":"+cErr.Code())
+	}
+	return got
+}
+
+func TestValidate(t *testing.T) {
+	price := -1.0
+	b := book{
+		Meta:     Meta{Tags: []string{"a", "b", "c"}},
+		Title:    "Go",
+		Genre:    "essay",
+		Pages:    0,
+		Price:    &price,
+		ISBN:     "978-x",
+		Author:   &author{Name: " "},
+		Chapters: []chapter{{Title: "One"}, {Title: ""}},
+	}
+
+	want := []string{
+		"tags:" + validators.CodeSliceTooMany,
+		"title:" + validators.CodeStringTooShort,
+		"genre:" + validators.CodeStringNotIncluded,
+		"pages:" + validators.CodeNotGreaterThanOrEqualTo,
+		"price:" + validators.CodeNotGreaterThanOrEqualTo,
+		"ISBN:" + validators.CodeStringNotMatched,
+		"author.name:" + validators.CodeStringBlank,
+		"chapters[1].Title:" + validators.CodeStringBlank,
+	}
+	// test validating by value and by pointer, twice for the cached plan
+	for _, v := range []interface{}{b, &b, &b} {
+		if got := errorsOf(t, tags.Validate(v)); !reflect.DeepEqual(got, want) {
+			t.Errorf("tags.Validate failed with errors=%v, want errors=%v", got, want)
+		}
+	}
+
+	// test nil pointers
+	b = book{Title: "Guard", Genre: "novel", Pages: 10, ISBN: "978"}
+	want = []string{"author:" + validators.CodeNil}
+	if got := errorsOf(t, tags.Validate(&b)); !reflect.DeepEqual(got, want) {
+		t.Errorf("tags.Validate failed with errors=%v, want errors=%v", got, want)
+	}
+	b.Author = &author{Name: "Rob"}
+	if err := tags.Validate(&b); err != nil {
+		t.Errorf("tags.Validate failed with err=%v", err)
+	}
+
+	// test rule notnil of the other nilable kinds
+	type labels struct {
+		Labels map[string]string `json:"labels" guard:"notnil"`
+		Tags   []string          `json:"tags" guard:"notnil,len=..1"`
+	}
+	want = []string{"labels:" + validators.CodeNil, "tags:" + validators.CodeNil}
+	if got := errorsOf(t, tags.Validate(labels{})); !reflect.DeepEqual(got, want) {
+		t.Errorf("tags.Validate failed with errors=%v, want errors=%v", got, want)
+	}
+}
+
+func TestValidateInvalidInput(t *testing.T) {
+	var nilBook *book
+	for _, v := range []interface{}{nil, 1, nilBook} {
+		err := tags.Validate(v)
+		if _, ok := err.(guard.Errors); err == nil || ok {
+			t.Errorf("tags.Validate failed with err=%v", err)
+		}
+	}
+
+	type badRule struct {
+		Count int `guard:"notblank"`
+	}
+	type badTag struct {
+		Name string `guard:"len=x"`
+	}
+	type badNotNil struct {
+		Name string `guard:"notnil"`
+	}
+	for _, v := range []interface{}{badRule{}, badTag{}, badNotNil{}} {
+		err := tags.Validate(v)
+		if _, ok := err.(guard.Errors); err == nil || ok {
+			t.Errorf("tags.Validate failed with err=%v", err)
+		}
+	}
+}
+
+type signup struct {
+	Email string `json:"email" guard:"email"`
+}
+
+// Validate implements interface guard.Validator
+func (s *signup) Validate() error {
+	return tags.Validate(s)
+}
+
+func TestStruct(t *testing.T) {
+	type form struct {
+		Signup  signup   `json:"signup"`
+		Signups []signup `json:"signups"`
+	}
+
+	err := guard.Validate(guard.Field("form", tags.Struct(&form{
+		Signup:  signup{Email: "nauyey@example.com"},
+		Signups: []signup{{Email: "bad"}},
+	})))
+	want := []string{"form.signups[0].email:" + validators.CodeEmailMalformed}
+	if got := errorsOf(t, err); !reflect.DeepEqual(got, want) {
+		t.Errorf("tags.Struct failed with errors=%v, want errors=%v", got, want)
+	}
+}
@@ -1,6 +1,9 @@
 package guard
 
-import "reflect"
+import (
+	"context"
+	"reflect"
+)
 
 // Validate executes the validators one by one.
 //
@@ -16,23 +19,30 @@ import "reflect"
 // Otherwise, there aren't any validation errors. And nil will be returned.
 //
 func Validate(validators ...Validator) error {
+	return ValidateContext(context.Background(), validators...)
+}
+
+// ValidateContext executes the validators one by one with the context ctx.
+//
+// It works the same as Validate, but ctx is passed down to every ContextValidator,
+// including the ones nested in strict validators.
+// Plain validators are executed by their Validate methods.
+//
+// If ctx is done, ValidateContext stops executing validators and returns ctx.Err().
+func ValidateContext(ctx context.Context, validators ...Validator) error {
 	errs := []error{}
 
 	for _, v := range validators {
-		if err := v.Validate(); err != nil {
-			switch vErr := err.(type) {
-			default:
-				return err
-			case Errors:
-				errs = append(errs, vErr.ValidationErrors()...)
-				if _, ok := v.(*strictValidators); ok {
-					return &errors{errs: errs}
-				}
-			case Error:
-				errs = append(errs, err)
-				if _, ok := v.(*strictValidators); ok {
-					return &errors{errs: errs}
-				}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := validate(ctx, v); err != nil {
+			var iErr error
+			if errs, iErr = collect(errs, err); iErr != nil {
+				return iErr
+			}
+			if _, ok := v.(*strictValidators); ok {
+				return &errors{errs: errs}
 			}
 		}
 	}
@@ -43,6 +53,26 @@ func Validate(validators ...Validator) error {
 	return nil
 }
 
+// collect appends the validation errors of err to errs.
+// If err isn't a validation error, it's returned as an internal error.
+func collect(errs []error, err error) ([]error, error) {
+	switch vErr := err.(type) {
+	default:
+		return errs, err
+	case Errors:
+		return append(errs, vErr.ValidationErrors()...), nil
+	case Error:
+		return append(errs, err), nil
+	}
+}
+
+func validate(ctx context.Context, v Validator) error {
+	if cv, ok := v.(ContextValidator); ok {
+		return cv.ValidateContext(ctx)
+	}
+	return v.Validate()
+}
+
 // Strict transfers a validator to be a strict validator.
 // If a strict valdator faild, guard.Validate will stop executing the next validator but return validation errors.
 func Strict(validators ...Validator) Validator {
@@ -54,6 +84,9 @@ func Strict(validators ...Validator) Validator {
 // AllowNil wraps a validator that if the validator is nil
 // then this validator will be always valid. Otherwise, the validator
 // will be the way as it before.
+//
+// The validator is nil if it's a nil interface, or a nil value of a nilable kind,
+// like a nil pointer, map, slice or func implementing the Validator interface.
 func AllowNil(v Validator) Validator {
 	return &allowNilValidator{
 		validator: v,
@@ -66,8 +99,16 @@ type strictValidators struct {
 
 // Validate implements the Validator interface
 func (v *strictValidators) Validate() error {
+	return v.ValidateContext(context.Background())
+}
+
+// ValidateContext implements the ContextValidator interface
+func (v *strictValidators) ValidateContext(ctx context.Context) error {
 	for _, v := range v.validators {
-		if err := v.Validate(); err != nil {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := validate(ctx, v); err != nil {
 			return err
 		}
 	}
@@ -83,11 +124,28 @@ type allowNilValidator struct {
 
 // Validate implements the Validator interface
 func (v *allowNilValidator) Validate() error {
-	vpv := reflect.ValueOf(v.validator)
-	if vpv.Kind() == reflect.Invalid || (vpv.Kind() == reflect.Ptr) && vpv.IsNil() {
+	return v.ValidateContext(context.Background())
+}
+
+// ValidateContext implements the ContextValidator interface
+func (v *allowNilValidator) ValidateContext(ctx context.Context) error {
+	if isNil(v.validator) {
 		return nil
 	}
-	return v.validator.Validate()
+	return validate(ctx, v.validator)
+}
+
+// isNil reports whether v is a nil interface, or a nil value of a nilable kind:
+// chan, func, interface, map, pointer, slice or unsafe pointer.
+func isNil(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice, reflect.UnsafePointer:
+		return rv.IsNil()
+	}
+	return false
 }
 
 type errors struct {
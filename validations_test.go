@@ -1,6 +1,7 @@
 package guard_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -37,6 +38,20 @@ func (v *testValidator) Validate() error {
 	return v.err
 }
 
+type testContextValidator struct {
+	err error
+	ctx context.Context
+}
+
+func (v *testContextValidator) Validate() error {
+	return v.ValidateContext(context.Background())
+}
+
+func (v *testContextValidator) ValidateContext(ctx context.Context) error {
+	v.ctx = ctx
+	return v.err
+}
+
 func TestValidate(t *testing.T) {
 	// test without validation errors
 	err := guard.Validate(
@@ -243,6 +258,21 @@ func TestAllowNil(t *testing.T) {
 		t.Errorf("guard.AllowNil failed to allow nil validators")
 	}
 
+	// test with nil validators of the other nilable kinds
+	var mv mapValidator
+	var fv funcValidator
+	err = guard.Validate(
+		guard.AllowNil(mv),
+		guard.AllowNil(fv),
+		guard.AllowNil(nil),
+	)
+	if err != nil {
+		t.Errorf("guard.AllowNil failed to allow nil validators")
+	}
+	if err := guard.Validate(guard.AllowNil(mapValidator{})); err == nil {
+		t.Errorf("guard.AllowNil failed")
+	}
+
 	// test with non-nil validator
 	err = guard.Validate(
 		guard.AllowNil(&testValidator{
@@ -253,3 +283,71 @@ func TestAllowNil(t *testing.T) {
 		t.Errorf("guard.AllowNil failed")
 	}
 }
+
+type mapValidator map[string]string
+
+func (v mapValidator) Validate() error {
+	return &validationError{}
+}
+
+type funcValidator func() error
+
+func (v funcValidator) Validate() error {
+	return v()
+}
+
+func TestValidateContext(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "value")
+
+	// test passing ctx down through nested and strict validators
+	v1 := &testContextValidator{}
+	v2 := &testContextValidator{}
+	v3 := &testContextValidator{err: &validationError{}}
+	err := guard.ValidateContext(ctx,
+		&testValidator{},
+		v1,
+		guard.Strict(guard.AllowNil(v2)),
+		guard.Field("name", v3),
+	)
+	if err == nil {
+		t.Fatalf("guard.ValidateContext failed with err=nil")
+	}
+	for _, v := range []*testContextValidator{v1, v2, v3} {
+		if v.ctx == nil || v.ctx.Value(key{}) != "value" {
+			t.Errorf("guard.ValidateContext failed to pass ctx down")
+		}
+	}
+
+	// test with canceled ctx
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	v4 := &testContextValidator{}
+	err = guard.ValidateContext(ctx, v4)
+	if err != context.Canceled {
+		t.Errorf("guard.ValidateContext failed with err=%v, want err=%v", err, context.Canceled)
+	}
+	if v4.ctx != nil {
+		t.Errorf("guard.ValidateContext failed to stop when ctx is done")
+	}
+
+	// test with ctx canceled in a strict validator
+	ctx, cancel = context.WithCancel(context.Background())
+	v5 := &testContextValidator{}
+	err = guard.ValidateContext(ctx, guard.Strict(&cancelValidator{cancel: cancel}, v5))
+	if err != context.Canceled {
+		t.Errorf("guard.ValidateContext failed with err=%v, want err=%v", err, context.Canceled)
+	}
+	if v5.ctx != nil {
+		t.Errorf("guard.ValidateContext failed to stop when ctx is done")
+	}
+}
+
+type cancelValidator struct {
+	cancel context.CancelFunc
+}
+
+func (v *cancelValidator) Validate() error {
+	v.cancel()
+	return nil
+}
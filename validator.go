@@ -1,5 +1,7 @@
 package guard
 
+import "context"
+
 // Validator is the interface that defines a validator.
 //
 // Validate executes the current validator instance.
@@ -11,3 +13,13 @@ package guard
 type Validator interface {
 	Validate() error
 }
+
+// ContextValidator is the interface that defines a context-aware validator.
+//
+// ValidateContext executes the current validator instance with the context ctx.
+// Validators which access databases or other services should honour the cancellation
+// and the deadline of ctx, and return ctx.Err() once ctx is done.
+type ContextValidator interface {
+	Validator
+	ValidateContext(ctx context.Context) error
+}
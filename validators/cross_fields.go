@@ -0,0 +1,218 @@
+package validators
+
+import (
+	"cmp"
+	"time"
+
+	"github.com/nauyey/guard"
+)
+
+// cross-field validation error messages
+const (
+	fieldsNotEqualMsg          = "should equal to the other field"
+	fieldsEqualMsg             = "shouldn't equal to the other field"
+	fieldsNotLessMsg           = "should be less than the other field"
+	fieldsNotLessOrEqualMsg    = "should be less than or equal to the other field"
+	fieldsNotGreaterMsg        = "should be greater than the other field"
+	fieldsNotGreaterOrEqualMsg = "should be greater than or equal to the other field"
+	fieldsNotBothSetMsg        = "should be set with the other field"
+	fieldsSetMsg               = "shouldn't be set with the other field"
+)
+
+// cross-field validation error codes
+const (
+	CodeFieldsNotEqual          = "fields.not_equal"
+	CodeFieldsEqual             = "fields.equal"
+	CodeFieldsNotLess           = "fields.not_less"
+	CodeFieldsNotLessOrEqual    = "fields.not_less_or_equal"
+	CodeFieldsNotGreater        = "fields.not_greater"
+	CodeFieldsNotGreaterOrEqual = "fields.not_greater_or_equal"
+	CodeFieldsNotBothSet        = "fields.not_both_set"
+	CodeFieldsSet               = "fields.set"
+)
+
+// Op is the operator to compare a field with the other field.
+type Op int
+
+// comparison operators
+const (
+	OpEqual Op = iota
+	OpNotEqual
+	OpLess
+	OpLessOrEqual
+	OpGreater
+	OpGreaterOrEqual
+)
+
+// holds reports whether the result c of comparing a field with the other field satisfies op.
+func (op Op) holds(c int) bool {
+	switch op {
+	case OpNotEqual:
+		return c != 0
+	case OpLess:
+		return c < 0
+	case OpLessOrEqual:
+		return c <= 0
+	case OpGreater:
+		return c > 0
+	case OpGreaterOrEqual:
+		return c >= 0
+	}
+	return c == 0
+}
+
+func (op Op) code() (code, msg string) {
+	switch op {
+	case OpNotEqual:
+		return CodeFieldsEqual, fieldsEqualMsg
+	case OpLess:
+		return CodeFieldsNotLess, fieldsNotLessMsg
+	case OpLessOrEqual:
+		return CodeFieldsNotLessOrEqual, fieldsNotLessOrEqualMsg
+	case OpGreater:
+		return CodeFieldsNotGreater, fieldsNotGreaterMsg
+	case OpGreaterOrEqual:
+		return CodeFieldsNotGreaterOrEqual, fieldsNotGreaterOrEqualMsg
+	}
+	return CodeFieldsNotEqual, fieldsNotEqualMsg
+}
+
+// CompareFields is a validator which will check whether the field Value compares with field Other by field Op,
+// like "password_confirmation" equals to "password", or "min_price" is less than or equal to "max_price".
+//
+// The validation error is bound to field path Name, and has the parameters "field" and "other",
+// which are field Name and OtherName.
+type CompareFields[T cmp.Ordered] struct {
+	Name      string
+	Value     T
+	Op        Op
+	OtherName string
+	Other     T
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *CompareFields[T]) Validate() error {
+	if v.Op.holds(cmp.Compare(v.Value, v.Other)) {
+		return nil
+	}
+	return v.error()
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *CompareFields[T]) OverrideMessage(msg string) *CompareFields[T] {
+	v.message = &msg
+	return v
+}
+
+func (v *CompareFields[T]) error() error {
+	code, msg := v.Op.code()
+	return fieldsError(v.Name, v.OtherName, code, v.message, msg)
+}
+
+// CompareTimeFields is a validator which will check whether the time field Value compares with field Other by field Op,
+// like "end_date" is after "start_date".
+//
+// It works the same as CompareFields, and the times are compared by time.Time.Compare.
+type CompareTimeFields struct {
+	Name      string
+	Value     time.Time
+	Op        Op
+	OtherName string
+	Other     time.Time
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *CompareTimeFields) Validate() error {
+	if v.Op.holds(v.Value.Compare(v.Other)) {
+		return nil
+	}
+	code, msg := v.Op.code()
+	return fieldsError(v.Name, v.OtherName, code, v.message, msg)
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *CompareTimeFields) OverrideMessage(msg string) *CompareTimeFields {
+	v.message = &msg
+	return v
+}
+
+// FieldsBothSet is a validator which will check whether both field Value and field Other are set,
+// which means they aren't nil or zero values. A value with an IsZero method, like time.Time, is zero if IsZero returns true,
+// and a nil pointer, like (*time.Time)(nil), is zero without calling its IsZero method.
+//
+// The validation error is bound to field path Name, and has the parameters "field" and "other".
+type FieldsBothSet[T comparable] struct {
+	Name      string
+	Value     T
+	OtherName string
+	Other     T
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *FieldsBothSet[T]) Validate() error {
+	if isZeroValue(v.Value) || isZeroValue(v.Other) {
+		return fieldsError(v.Name, v.OtherName, CodeFieldsNotBothSet, v.message, fieldsNotBothSetMsg)
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *FieldsBothSet[T]) OverrideMessage(msg string) *FieldsBothSet[T] {
+	v.message = &msg
+	return v
+}
+
+// FieldsNeitherSet is a validator which will check whether neither field Value nor field Other is set.
+// It works the same as FieldsBothSet otherwise.
+type FieldsNeitherSet[T comparable] struct {
+	Name      string
+	Value     T
+	OtherName string
+	Other     T
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *FieldsNeitherSet[T]) Validate() error {
+	if !isZeroValue(v.Value) || !isZeroValue(v.Other) {
+		return fieldsError(v.Name, v.OtherName, CodeFieldsSet, v.message, fieldsSetMsg)
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *FieldsNeitherSet[T]) OverrideMessage(msg string) *FieldsNeitherSet[T] {
+	v.message = &msg
+	return v
+}
+
+// fieldsError returns a cross-field validation error bound to the field path name.
+func fieldsError(name, other, code string, message *string, defaultMsg string) error {
+	err := &validationError{
+		msg:        returnDefaultStringIfNil(message, defaultMsg),
+		code:       code,
+		params:     map[string]interface{}{"field": name, "other": other},
+		overridden: message != nil,
+	}
+	if name == "" {
+		return err
+	}
+	return guard.Field(name, errorValidator{err: err}).Validate()
+}
+
+// errorValidator is a validator which always returns err.
+type errorValidator struct {
+	err error
+}
+
+// Validate implements the guard.Validator interface
+func (v errorValidator) Validate() error {
+	return v.err
+}
@@ -0,0 +1,116 @@
+package validators_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nauyey/guard"
+	"github.com/nauyey/guard/validators"
+)
+
+func TestCompareFields(t *testing.T) {
+	tests := []struct {
+		value, other int
+		op           validators.Op
+		code         string
+	}{
+		{1, 1, validators.OpEqual, ""},
+		{1, 2, validators.OpEqual, validators.CodeFieldsNotEqual},
+		{1, 2, validators.OpNotEqual, ""},
+		{1, 1, validators.OpNotEqual, validators.CodeFieldsEqual},
+		{1, 2, validators.OpLess, ""},
+		{2, 2, validators.OpLess, validators.CodeFieldsNotLess},
+		{2, 2, validators.OpLessOrEqual, ""},
+		{3, 2, validators.OpLessOrEqual, validators.CodeFieldsNotLessOrEqual},
+		{3, 2, validators.OpGreater, ""},
+		{2, 2, validators.OpGreater, validators.CodeFieldsNotGreater},
+		{2, 2, validators.OpGreaterOrEqual, ""},
+		{1, 2, validators.OpGreaterOrEqual, validators.CodeFieldsNotGreaterOrEqual},
+	}
+	for _, test := range tests {
+		err := (&validators.CompareFields[int]{Name: "min_price", Value: test.value, Op: test.op, OtherName: "max_price", Other: test.other}).Validate()
+		if code := codeOf(errors.Unwrap(err)); code != test.code {
+			t.Errorf("validators.CompareFields faild with value=%d, other=%d, op=%d, code=%q, want code=%q", test.value, test.other, test.op, code, test.code)
+		}
+	}
+
+	// test field path and params
+	err := guard.Validate(guard.Field("user", &validators.CompareFields[string]{
+		Name:      "password_confirmation",
+		Value:     "secret",
+		OtherName: "password",
+		Other:     "Secret",
+	}))
+	errs, ok := err.(guard.Errors)
+	if !ok || len(errs.ValidationErrors()) != 1 {
+		t.Fatalf("validators.CompareFields faild with err=%v", err)
+	}
+	vErr := errs.ValidationErrors()[0]
+	if fErr, ok := vErr.(guard.FieldError); !ok || fErr.Field() != "user.password_confirmation" {
+		t.Errorf("validators.CompareFields faild with err=%v", vErr)
+	}
+	var cErr guard.CodedError
+	if !errors.As(vErr, &cErr) || cErr.Params()["field"] != "password_confirmation" || cErr.Params()["other"] != "password" {
+		t.Errorf("validators.CompareFields faild with err=%v", vErr)
+	}
+
+	// test override error message
+	err = (&validators.CompareFields[float64]{Value: 1, OtherName: "b", Other: 2}).OverrideMessage("override error message").Validate()
+	if err == nil || err.Error() != "override error message" {
+		t.Errorf("validators.CompareFields faild")
+	}
+}
+
+func TestCompareTimeFields(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	if err := (&validators.CompareTimeFields{Name: "end_date", Value: end, Op: validators.OpGreater, OtherName: "start_date", Other: start}).Validate(); err != nil {
+		t.Errorf("validators.CompareTimeFields faild")
+	}
+	err := (&validators.CompareTimeFields{Name: "end_date", Value: start, Op: validators.OpGreater, OtherName: "start_date", Other: end}).Validate()
+	if fErr, ok := err.(guard.FieldError); !ok || fErr.Field() != "end_date" || codeOf(errors.Unwrap(err)) != validators.CodeFieldsNotGreater {
+		t.Errorf("validators.CompareTimeFields faild with err=%v", err)
+	}
+
+	// test equal times in different locations
+	if err := (&validators.CompareTimeFields{Value: start, Other: start.In(time.FixedZone("CST", 8*3600))}).Validate(); err != nil {
+		t.Errorf("validators.CompareTimeFields faild")
+	}
+}
+
+func TestFieldsBothSet(t *testing.T) {
+	if err := (&validators.FieldsBothSet[string]{Name: "a", Value: "x", OtherName: "b", Other: "y"}).Validate(); err != nil {
+		t.Errorf("validators.FieldsBothSet faild")
+	}
+	if err := (&validators.FieldsBothSet[int]{Name: "a", Value: 1, OtherName: "b"}).Validate(); err == nil {
+		t.Errorf("validators.FieldsBothSet faild")
+	}
+	if err := (&validators.FieldsBothSet[time.Time]{Name: "a", Value: time.Now(), OtherName: "b"}).Validate(); err == nil {
+		t.Errorf("validators.FieldsBothSet faild")
+	}
+	now := time.Now()
+	if err := (&validators.FieldsBothSet[*time.Time]{Name: "a", Value: &now, OtherName: "b"}).Validate(); err == nil {
+		t.Errorf("validators.FieldsBothSet faild")
+	}
+	if err := (&validators.FieldsBothSet[*time.Time]{Name: "a", Value: &now, OtherName: "b", Other: &now}).Validate(); err != nil {
+		t.Errorf("validators.FieldsBothSet faild")
+	}
+}
+
+func TestFieldsNeitherSet(t *testing.T) {
+	if err := (&validators.FieldsNeitherSet[string]{Name: "a", OtherName: "b"}).Validate(); err != nil {
+		t.Errorf("validators.FieldsNeitherSet faild")
+	}
+	if err := (&validators.FieldsNeitherSet[time.Time]{Name: "a", OtherName: "b", Other: time.Time{}.In(time.FixedZone("CST", 8*3600))}).Validate(); err != nil {
+		t.Errorf("validators.FieldsNeitherSet faild")
+	}
+	if err := (&validators.FieldsNeitherSet[*time.Time]{Name: "a", OtherName: "b"}).Validate(); err != nil {
+		t.Errorf("validators.FieldsNeitherSet faild")
+	}
+	err := (&validators.FieldsNeitherSet[string]{Name: "a", OtherName: "b", Other: "y"}).Validate()
+	if codeOf(errors.Unwrap(err)) != validators.CodeFieldsSet {
+		t.Errorf("validators.FieldsNeitherSet faild with err=%v", err)
+	}
+}
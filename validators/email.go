@@ -0,0 +1,136 @@
+package validators
+
+import (
+	"net/mail"
+	"strings"
+)
+
+// email validation error messages
+const (
+	emailMalformedMsg        = "should be an email address"
+	emailDomainNotAllowedMsg = "email domain isn't allowed"
+	emailTooLongMsg          = "email address too long"
+)
+
+// email validation error codes
+const (
+	CodeEmailMalformed        = "email.malformed"
+	CodeEmailDomainNotAllowed = "email.domain_not_allowed"
+	CodeEmailTooLong          = "email.too_long"
+)
+
+// length limits of email addresses by RFC 5321
+const (
+	maxEmailLocalLength   = 64
+	maxEmailDomainLength  = 255
+	maxEmailAddressLength = 254
+)
+
+// Email is a validator which will check whether the field Value is an email address.
+//
+// The address is parsed by the semantics of net/mail, so "Name <user@example.com>" and
+// "\"user name\"@example.com" are valid email addresses by default.
+// Field RejectDisplayName and RejectQuotedLocal reject them, and field RequireDottedDomain
+// rejects domains without dots, like "user@localhost".
+// Comments, like "user@example.com (work)", are rejected as display names.
+//
+// The length limits of RFC 5321 are always checked: the local part is at most 64 bytes,
+// the domain is at most 255 bytes and the address is at most 254 bytes.
+//
+// If field AllowedDomains isn't empty, the domain must be one of them or their subdomains.
+// The domain mustn't be any of field DeniedDomains or their subdomains.
+// Domains are compared case-insensitively.
+type Email struct {
+	Value               string
+	RequireDottedDomain bool
+	RejectDisplayName   bool
+	RejectQuotedLocal   bool
+	AllowedDomains      []string
+	DeniedDomains       []string
+
+	malformedMessage        *string
+	domainNotAllowedMessage *string
+	tooLongMessage          *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *Email) Validate() error {
+	addr, err := mail.ParseAddress(v.Value)
+	if err != nil {
+		return v.malformed()
+	}
+
+	at := strings.LastIndexByte(addr.Address, '@')
+	local, domain := addr.Address[:at], addr.Address[at+1:]
+
+	// spec is the input without the surrounding spaces and angle brackets. It equals the parsed
+	// address unless the input has a display name, comments, or a quoted local part.
+	spec := strings.TrimSpace(v.Value)
+	if strings.HasPrefix(spec, "<") && strings.HasSuffix(spec, ">") {
+		spec = spec[1 : len(spec)-1]
+	}
+	quoted := strings.HasPrefix(spec, `"`)
+	if v.RejectDisplayName && (addr.Name != "" || (!quoted && spec != addr.Address) || (quoted && !strings.HasSuffix(spec, `"@`+domain))) {
+		return v.malformed()
+	}
+	if v.RejectQuotedLocal && quoted {
+		return v.malformed()
+	}
+	if v.RequireDottedDomain && (!strings.Contains(domain, ".") || strings.HasSuffix(domain, ".")) {
+		return v.malformed()
+	}
+
+	if len(local) > maxEmailLocalLength || len(domain) > maxEmailDomainLength || len(addr.Address) > maxEmailAddressLength {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.tooLongMessage, emailTooLongMsg),
+			overridden: v.tooLongMessage != nil,
+			code:       CodeEmailTooLong,
+			params:     map[string]interface{}{"max": maxEmailAddressLength},
+		}
+	}
+
+	if (len(v.AllowedDomains) != 0 && !matchDomain(v.AllowedDomains, domain)) || matchDomain(v.DeniedDomains, domain) {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.domainNotAllowedMessage, emailDomainNotAllowedMsg),
+			overridden: v.domainNotAllowedMessage != nil,
+			code:       CodeEmailDomainNotAllowed,
+			params:     map[string]interface{}{"domain": domain},
+		}
+	}
+
+	return nil
+}
+
+func (v *Email) malformed() error {
+	return &validationError{msg: returnDefaultStringIfNil(v.malformedMessage, emailMalformedMsg), overridden: v.malformedMessage != nil, code: CodeEmailMalformed}
+}
+
+// OverrideMalformedMessage overrides the error message of the malformed email address validation
+func (v *Email) OverrideMalformedMessage(msg string) *Email {
+	v.malformedMessage = &msg
+	return v
+}
+
+// OverrideDomainNotAllowedMessage overrides the error message of the email domain validation
+func (v *Email) OverrideDomainNotAllowedMessage(msg string) *Email {
+	v.domainNotAllowedMessage = &msg
+	return v
+}
+
+// OverrideTooLongMessage overrides the error message of the too long email address validation
+func (v *Email) OverrideTooLongMessage(msg string) *Email {
+	v.tooLongMessage = &msg
+	return v
+}
+
+// matchDomain reports whether domain is one of domains or their subdomains.
+func matchDomain(domains []string, domain string) bool {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	for _, d := range domains {
+		d = strings.ToLower(strings.TrimSuffix(d, "."))
+		if domain == d || strings.HasSuffix(domain, "."+d) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package validators_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nauyey/guard/validators"
+)
+
+func TestEmail(t *testing.T) {
+	tests := []struct {
+		validator *validators.Email
+		code      string
+	}{
+		{&validators.Email{Value: "user@example.com"}, ""},
+		{&validators.Email{Value: "User <user@example.com>"}, ""},
+		{&validators.Email{Value: `"user name"@example.com`}, ""},
+		{&validators.Email{Value: "user@localhost"}, ""},
+		{&validators.Email{Value: ""}, validators.CodeEmailMalformed},
+		{&validators.Email{Value: "user"}, validators.CodeEmailMalformed},
+		{&validators.Email{Value: "user@"}, validators.CodeEmailMalformed},
+		{&validators.Email{Value: "@example.com"}, validators.CodeEmailMalformed},
+		{&validators.Email{Value: "user@@example.com"}, validators.CodeEmailMalformed},
+		{&validators.Email{Value: "user@localhost", RequireDottedDomain: true}, validators.CodeEmailMalformed},
+		{&validators.Email{Value: "user@mail.example.com", RequireDottedDomain: true}, ""},
+		{&validators.Email{Value: "User <user@example.com>", RejectDisplayName: true}, validators.CodeEmailMalformed},
+		{&validators.Email{Value: "user@example.com", RejectDisplayName: true}, ""},
+		{&validators.Email{Value: "<user@example.com>", RejectDisplayName: true}, ""},
+		{&validators.Email{Value: `"a<b"@example.com`, RejectDisplayName: true}, ""},
+		{&validators.Email{Value: "user@example.com (work)", RejectDisplayName: true}, validators.CodeEmailMalformed},
+		{&validators.Email{Value: `"user"(work)@example.com`, RejectDisplayName: true}, validators.CodeEmailMalformed},
+		{&validators.Email{Value: `"user name"@example.com`, RejectQuotedLocal: true}, validators.CodeEmailMalformed},
+		{&validators.Email{Value: `"user"@example.com`, RejectQuotedLocal: true}, validators.CodeEmailMalformed},
+		{&validators.Email{Value: strings.Repeat("a", 65) + "@example.com"}, validators.CodeEmailTooLong},
+		{&validators.Email{Value: "user@" + strings.Repeat("a", 250) + ".com"}, validators.CodeEmailTooLong},
+		{&validators.Email{Value: "user@Example.COM", AllowedDomains: []string{"example.com"}}, ""},
+		{&validators.Email{Value: "user@mail.example.com", AllowedDomains: []string{"example.com"}}, ""},
+		{&validators.Email{Value: "user@badexample.com", AllowedDomains: []string{"example.com"}}, validators.CodeEmailDomainNotAllowed},
+		{&validators.Email{Value: "user@mailinator.com", DeniedDomains: []string{"mailinator.com"}}, validators.CodeEmailDomainNotAllowed},
+		{&validators.Email{Value: "user@example.com", DeniedDomains: []string{"mailinator.com"}}, ""},
+	}
+
+	for _, test := range tests {
+		if code := codeOf(test.validator.Validate()); code != test.code {
+			t.Errorf("validators.Email faild with value=%q, code=%q, want code=%q", test.validator.Value, code, test.code)
+		}
+	}
+
+	// test override error message
+	err := (&validators.Email{Value: "user"}).OverrideMalformedMessage("override error message").Validate()
+	if err == nil || err.Error() != "override error message" {
+		t.Errorf("validators.Email faild")
+	}
+}
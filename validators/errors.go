@@ -1,7 +1,12 @@
 package validators
 
 type validationError struct {
-	msg string
+	msg    string
+	code   string
+	params map[string]interface{}
+
+	// overridden is true if msg is set by OverrideMessage
+	overridden bool
 }
 
 // Error implements the error interface
@@ -11,3 +16,19 @@ func (err *validationError) Error() string {
 
 // ValidationError implements the guard.Error interface
 func (err *validationError) ValidationError() {}
+
+// Code implements the guard.CodedError interface
+func (err *validationError) Code() string {
+	return err.code
+}
+
+// Params implements the guard.CodedError interface
+func (err *validationError) Params() map[string]interface{} {
+	return err.params
+}
+
+// Overridden reports whether the message is overridden by OverrideMessage.
+// The translations keep overridden messages.
+func (err *validationError) Overridden() bool {
+	return err.overridden
+}
@@ -0,0 +1,49 @@
+package validators_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nauyey/guard"
+	"github.com/nauyey/guard/validators"
+)
+
+func TestErrorCodes(t *testing.T) {
+	tests := []struct {
+		validator guard.Validator
+		code      string
+		params    map[string]interface{}
+	}{
+		{&validators.NotNil{}, validators.CodeNil, nil},
+		{&validators.IsOdd{Value: 4}, validators.CodeNotOdd, nil},
+		{&validators.IsEven{Value: 5}, validators.CodeNotEven, nil},
+		{&validators.IntGreaterThan{Value: 5, Target: 5}, validators.CodeNotGreaterThan, map[string]interface{}{"target": 5}},
+		{&validators.IntGreaterThanOrEqualTo{Value: 4, Target: 5}, validators.CodeNotGreaterThanOrEqualTo, map[string]interface{}{"target": 5}},
+		{&validators.IntEqualTo{Value: 4, Target: 5}, validators.CodeNotEqualTo, map[string]interface{}{"target": 5}},
+		{&validators.IntLessThan{Value: 5, Target: 5}, validators.CodeNotLessThan, map[string]interface{}{"target": 5}},
+		{&validators.IntLessThanOrEqualTo{Value: 6, Target: 5}, validators.CodeNotLessThanOrEqualTo, map[string]interface{}{"target": 5}},
+		{&validators.IntInRange{Value: 4, Left: 5, Right: 10}, validators.CodeOutOfLeftRange, map[string]interface{}{"left": 5, "right": 10}},
+		{&validators.IntInRange{Value: 11, Left: 5, Right: 10}, validators.CodeOutOfRightRange, map[string]interface{}{"left": 5, "right": 10}},
+		{&validators.StringNotBlank{}, validators.CodeStringBlank, nil},
+		{&validators.StringInclusion{Value: "a", In: []string{"b"}}, validators.CodeStringNotIncluded, map[string]interface{}{"in": []string{"b"}}},
+		{&validators.StringExclusion{Value: "b", In: []string{"b"}}, validators.CodeStringExcluded, map[string]interface{}{"in": []string{"b"}}},
+		{&validators.StringLength{Value: "a", Min: 2, Max: 3}, validators.CodeStringTooShort, map[string]interface{}{"min": 2, "max": 3}},
+		{&validators.StringLength{Value: "abcd", Min: 2, Max: 3}, validators.CodeStringTooLong, map[string]interface{}{"min": 2, "max": 3}},
+	}
+
+	for _, test := range tests {
+		err, ok := test.validator.Validate().(guard.CodedError)
+		if !ok {
+			t.Errorf("%T failed to return err(type guard.CodedError)", test.validator)
+			continue
+		}
+		if err.Code() != test.code {
+			t.Errorf("%T failed with code=%q, want code=%q", test.validator, err.Code(), test.code)
+		}
+		if len(err.Params()) != 0 || len(test.params) != 0 {
+			if !reflect.DeepEqual(err.Params(), test.params) {
+				t.Errorf("%T failed with params=%v, want params=%v", test.validator, err.Params(), test.params)
+			}
+		}
+	}
+}
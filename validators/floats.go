@@ -0,0 +1,261 @@
+package validators
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// float validation error messages
+const (
+	notNaNMsg                 = "should be a number"
+	finiteMsg                 = "should be finite"
+	approxEqualMsg            = "should approximately equal to"
+	floatRangeMinMsg          = "should be greater than or equal to min"
+	floatRangeMinExclusiveMsg = "should be greater than min"
+	floatRangeMaxMsg          = "should be less than or equal to max"
+	floatRangeMaxExclusiveMsg = "should be less than max"
+	tooManyDecimalPlacesMsg   = "too many decimal places"
+)
+
+// float validation error codes
+const (
+	CodeNaN                  = "float.nan"
+	CodeNotFinite            = "float.not_finite"
+	CodeNotApproxEqual       = "float.not_approx_equal"
+	CodeBelowRange           = "float.below_range"
+	CodeAboveRange           = "float.above_range"
+	CodeTooManyDecimalPlaces = "float.too_many_decimal_places"
+)
+
+// Float is the constraint of the float types supported by the float validators.
+type Float interface {
+	~float32 | ~float64
+}
+
+// NotNaN is a validator which will check whether the field Value is not NaN.
+type NotNaN[T Float] struct {
+	Value T
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *NotNaN[T]) Validate() error {
+	if math.IsNaN(float64(v.Value)) {
+		return &validationError{msg: returnDefaultStringIfNil(v.message, notNaNMsg), overridden: v.message != nil, code: CodeNaN}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *NotNaN[T]) OverrideMessage(msg string) *NotNaN[T] {
+	v.message = &msg
+	return v
+}
+
+// Finite is a validator which will check whether the field Value is neither NaN nor ±Inf.
+type Finite[T Float] struct {
+	Value T
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *Finite[T]) Validate() error {
+	f := float64(v.Value)
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return &validationError{msg: returnDefaultStringIfNil(v.message, finiteMsg), overridden: v.message != nil, code: CodeNotFinite}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *Finite[T]) OverrideMessage(msg string) *Finite[T] {
+	v.message = &msg
+	return v
+}
+
+// ApproxEqual is a validator which will check whether the field Value approximately equals to field Target.
+//
+// Value and Target are approximately equal if the absolute difference between them is
+// less than or equal to field AbsEpsilon, or less than or equal to field RelEpsilon times
+// the larger magnitude of them. ±Inf only equals to itself, and NaN never equals to anything.
+// A non-finite Target is the parameter "target" as a string, like "+Inf".
+type ApproxEqual[T Float] struct {
+	Value      T
+	Target     T
+	AbsEpsilon float64
+	RelEpsilon float64
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *ApproxEqual[T]) Validate() error {
+	if !approxEqual(float64(v.Value), float64(v.Target), v.AbsEpsilon, v.RelEpsilon) {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, approxEqualMsg),
+			overridden: v.message != nil,
+			code:       CodeNotApproxEqual,
+			params: map[string]interface{}{
+				"target":      floatParam(v.Target),
+				"abs_epsilon": v.AbsEpsilon,
+				"rel_epsilon": v.RelEpsilon,
+			},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *ApproxEqual[T]) OverrideMessage(msg string) *ApproxEqual[T] {
+	v.message = &msg
+	return v
+}
+
+// floatParam returns f as a validation error parameter. The non-finite floats are returned as
+// the strings "+Inf", "-Inf" and "NaN", so that the parameters can be encoded in JSON.
+func floatParam[T Float](f T) interface{} {
+	if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+		return strconv.FormatFloat(float64(f), 'g', -1, 64)
+	}
+	return f
+}
+
+func approxEqual(a, b, absEpsilon, relEpsilon float64) bool {
+	if a == b {
+		return true
+	}
+	if math.IsNaN(a) || math.IsNaN(b) || math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return false
+	}
+
+	diff := math.Abs(a - b)
+	return diff <= absEpsilon || diff <= relEpsilon*math.Max(math.Abs(a), math.Abs(b))
+}
+
+// FloatRange is a validator which will check whether the field Value is in range of field Min and Max.
+//
+// The ends of the range are inclusive by default. Field MinExclusive and MaxExclusive make them exclusive.
+// A NaN Value is always invalid, and reported with the message set by OverrideMinMessage,
+// or by OverrideMaxMessage if there isn't one.
+// Infinite Min and Max, which are unbounded ends, are the parameters "min" and "max" as strings, like "+Inf".
+type FloatRange[T Float] struct {
+	Value        T
+	Min          T
+	Max          T
+	MinExclusive bool
+	MaxExclusive bool
+
+	minMessage *string
+	maxMessage *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *FloatRange[T]) Validate() error {
+	if math.IsNaN(float64(v.Value)) {
+		message := v.minMessage
+		if message == nil {
+			message = v.maxMessage
+		}
+		return &validationError{msg: returnDefaultStringIfNil(message, notNaNMsg), overridden: message != nil, code: CodeNaN}
+	}
+
+	if v.Value < v.Min || v.MinExclusive && v.Value == v.Min {
+		msg := floatRangeMinMsg
+		if v.MinExclusive {
+			msg = floatRangeMinExclusiveMsg
+		}
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.minMessage, msg),
+			overridden: v.minMessage != nil,
+			code:       CodeBelowRange,
+			params:     v.params(),
+		}
+	}
+
+	if v.Value > v.Max || v.MaxExclusive && v.Value == v.Max {
+		msg := floatRangeMaxMsg
+		if v.MaxExclusive {
+			msg = floatRangeMaxExclusiveMsg
+		}
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.maxMessage, msg),
+			overridden: v.maxMessage != nil,
+			code:       CodeAboveRange,
+			params:     v.params(),
+		}
+	}
+
+	return nil
+}
+
+func (v *FloatRange[T]) params() map[string]interface{} {
+	return map[string]interface{}{
+		"min":           floatParam(v.Min),
+		"max":           floatParam(v.Max),
+		"min_exclusive": v.MinExclusive,
+		"max_exclusive": v.MaxExclusive,
+	}
+}
+
+// OverrideMinMessage overrides the error message of the below range validation
+func (v *FloatRange[T]) OverrideMinMessage(msg string) *FloatRange[T] {
+	v.minMessage = &msg
+	return v
+}
+
+// OverrideMaxMessage overrides the error message of the above range validation
+func (v *FloatRange[T]) OverrideMaxMessage(msg string) *FloatRange[T] {
+	v.maxMessage = &msg
+	return v
+}
+
+// MaxDecimalPlaces is a validator which will check whether the field Value has at most field Places decimal places.
+//
+// The decimal places are counted by the shortest decimal representation of Value,
+// so 0.1 + 0.2 has 17 decimal places but 0.3 has 1.
+// NaN and ±Inf are always invalid.
+type MaxDecimalPlaces[T Float] struct {
+	Value  T
+	Places int
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *MaxDecimalPlaces[T]) Validate() error {
+	if decimalPlaces(v.Value) > v.Places {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, tooManyDecimalPlacesMsg),
+			overridden: v.message != nil,
+			code:       CodeTooManyDecimalPlaces,
+			params:     map[string]interface{}{"places": v.Places},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *MaxDecimalPlaces[T]) OverrideMessage(msg string) *MaxDecimalPlaces[T] {
+	v.message = &msg
+	return v
+}
+
+func decimalPlaces[T Float](f T) int {
+	if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+		return math.MaxInt
+	}
+
+	bitSize := 64
+	if reflect.TypeOf(f).Kind() == reflect.Float32 {
+		bitSize = 32
+	}
+	s := strconv.FormatFloat(float64(f), 'f', -1, bitSize)
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		return len(s) - i - 1
+	}
+	return 0
+}
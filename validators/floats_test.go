@@ -0,0 +1,133 @@
+package validators_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nauyey/guard"
+	"github.com/nauyey/guard/validators"
+)
+
+func TestNotNaN(t *testing.T) {
+	if err := (&validators.NotNaN[float64]{Value: math.Inf(1)}).Validate(); err != nil {
+		t.Errorf("validators.NotNaN faild")
+	}
+	if err := (&validators.NotNaN[float32]{Value: float32(math.NaN())}).Validate(); err == nil {
+		t.Errorf("validators.NotNaN faild")
+	}
+}
+
+func TestFinite(t *testing.T) {
+	if err := (&validators.Finite[float64]{Value: 1.5}).Validate(); err != nil {
+		t.Errorf("validators.Finite faild")
+	}
+	if err := (&validators.Finite[float64]{Value: math.Inf(-1)}).Validate(); err == nil {
+		t.Errorf("validators.Finite faild")
+	}
+	if err := (&validators.Finite[float64]{Value: math.NaN()}).Validate(); err == nil {
+		t.Errorf("validators.Finite faild")
+	}
+
+	// test override error message
+	err := (&validators.Finite[float64]{Value: math.NaN()}).OverrideMessage("override error message").Validate()
+	if err == nil || err.Error() != "override error message" {
+		t.Errorf("validators.Finite faild")
+	}
+}
+
+func TestApproxEqual(t *testing.T) {
+	a, b := 0.1, 0.2
+
+	if err := (&validators.ApproxEqual[float64]{Value: a + b, Target: 0.3}).Validate(); err == nil {
+		t.Errorf("validators.ApproxEqual faild")
+	}
+	if err := (&validators.ApproxEqual[float64]{Value: a + b, Target: 0.3, AbsEpsilon: 1e-9}).Validate(); err != nil {
+		t.Errorf("validators.ApproxEqual faild")
+	}
+	if err := (&validators.ApproxEqual[float64]{Value: 1e10 + 1, Target: 1e10, RelEpsilon: 1e-9}).Validate(); err != nil {
+		t.Errorf("validators.ApproxEqual faild")
+	}
+	if err := (&validators.ApproxEqual[float64]{Value: 1e10 + 100, Target: 1e10, RelEpsilon: 1e-9}).Validate(); err == nil {
+		t.Errorf("validators.ApproxEqual faild")
+	}
+	if err := (&validators.ApproxEqual[float64]{Value: math.Inf(1), Target: math.Inf(1)}).Validate(); err != nil {
+		t.Errorf("validators.ApproxEqual faild")
+	}
+	if err := (&validators.ApproxEqual[float64]{Value: math.Inf(1), Target: math.MaxFloat64, RelEpsilon: 1}).Validate(); err == nil {
+		t.Errorf("validators.ApproxEqual faild")
+	}
+	if err := (&validators.ApproxEqual[float64]{Value: math.NaN(), Target: math.NaN(), AbsEpsilon: 1}).Validate(); err == nil {
+		t.Errorf("validators.ApproxEqual faild")
+	}
+	err := (&validators.ApproxEqual[float64]{Value: 1, Target: math.Inf(-1)}).Validate()
+	if err == nil || err.(guard.CodedError).Params()["target"] != "-Inf" {
+		t.Errorf("validators.ApproxEqual faild with err=%v", err)
+	}
+}
+
+func TestFloatRange(t *testing.T) {
+	if err := (&validators.FloatRange[float64]{Value: 0, Min: 0, Max: 1}).Validate(); err != nil {
+		t.Errorf("validators.FloatRange faild")
+	}
+	if err := (&validators.FloatRange[float64]{Value: 1, Min: 0, Max: 1}).Validate(); err != nil {
+		t.Errorf("validators.FloatRange faild")
+	}
+	if err := (&validators.FloatRange[float64]{Value: 0, Min: 0, Max: 1, MinExclusive: true}).Validate(); err == nil {
+		t.Errorf("validators.FloatRange faild")
+	}
+	if err := (&validators.FloatRange[float64]{Value: 1, Min: 0, Max: 1, MaxExclusive: true}).Validate(); err == nil {
+		t.Errorf("validators.FloatRange faild")
+	}
+	if err := (&validators.FloatRange[float64]{Value: math.NaN(), Min: 0, Max: 1}).Validate(); err == nil {
+		t.Errorf("validators.FloatRange faild")
+	}
+	if err := (&validators.FloatRange[float64]{Value: math.Inf(1), Min: 0, Max: math.Inf(1)}).Validate(); err != nil {
+		t.Errorf("validators.FloatRange faild")
+	}
+
+	// test override error message
+	err := (&validators.FloatRange[float64]{Value: -1, Min: 0, Max: 1}).Validate()
+	if err == nil || err.Error() != "should be greater than or equal to min" {
+		t.Errorf("validators.FloatRange faild")
+	}
+	err = (&validators.FloatRange[float64]{Value: 0, Min: 0, Max: 1, MinExclusive: true}).Validate()
+	if err == nil || err.Error() != "should be greater than min" {
+		t.Errorf("validators.FloatRange faild")
+	}
+	err = (&validators.FloatRange[float64]{Value: 2, Min: 0, Max: 1}).OverrideMaxMessage("override error message").Validate()
+	if err == nil || err.Error() != "override error message" {
+		t.Errorf("validators.FloatRange faild")
+	}
+	err = (&validators.FloatRange[float64]{Value: math.NaN(), Min: 0, Max: 1}).OverrideMinMessage("override error message").Validate()
+	if err == nil || err.Error() != "override error message" || codeOf(err) != validators.CodeNaN {
+		t.Errorf("validators.FloatRange faild")
+	}
+	err = (&validators.FloatRange[float64]{Value: math.NaN(), Min: 0, Max: 1}).OverrideMaxMessage("override error message").Validate()
+	if err == nil || err.Error() != "override error message" {
+		t.Errorf("validators.FloatRange faild")
+	}
+
+	// test infinite ends
+	err = (&validators.FloatRange[float64]{Value: -1, Min: 0, Max: math.Inf(1)}).Validate()
+	if err == nil || err.(guard.CodedError).Params()["max"] != "+Inf" || err.(guard.CodedError).Params()["min"] != 0.0 {
+		t.Errorf("validators.FloatRange faild with err=%v", err)
+	}
+}
+
+func TestMaxDecimalPlaces(t *testing.T) {
+	if err := (&validators.MaxDecimalPlaces[float64]{Value: 9.99, Places: 2}).Validate(); err != nil {
+		t.Errorf("validators.MaxDecimalPlaces faild")
+	}
+	if err := (&validators.MaxDecimalPlaces[float64]{Value: 10, Places: 0}).Validate(); err != nil {
+		t.Errorf("validators.MaxDecimalPlaces faild")
+	}
+	if err := (&validators.MaxDecimalPlaces[float32]{Value: 0.1, Places: 1}).Validate(); err != nil {
+		t.Errorf("validators.MaxDecimalPlaces faild")
+	}
+	if err := (&validators.MaxDecimalPlaces[float64]{Value: 9.999, Places: 2}).Validate(); err == nil {
+		t.Errorf("validators.MaxDecimalPlaces faild")
+	}
+	if err := (&validators.MaxDecimalPlaces[float64]{Value: math.Inf(1), Places: 2}).Validate(); err == nil {
+		t.Errorf("validators.MaxDecimalPlaces faild")
+	}
+}
@@ -0,0 +1,175 @@
+package validators
+
+// group validation error messages
+const (
+	notExactlyOneMsg   = "exactly one of the fields should be present"
+	nonePresentMsg     = "at least one of the fields should be present"
+	manyPresentMsg     = "at most one of the fields should be present"
+	missingRequiredMsg = "the fields are required"
+)
+
+// group validation error codes
+const (
+	CodeGroupNotExactlyOne   = "group.not_exactly_one"
+	CodeGroupNonePresent     = "group.none_present"
+	CodeGroupManyPresent     = "group.many_present"
+	CodeGroupMissingRequired = "group.missing_required"
+)
+
+// Named is a value named by its field name, like {Name: "card_token", Value: order.CardToken}.
+//
+// The group validators check whether the values are present, which means
+// they aren't nil, blank strings or zero values.
+type Named struct {
+	Name  string
+	Value interface{}
+}
+
+// presentNames returns the names of the present values of fields.
+func presentNames(fields []Named) []string {
+	names := []string{}
+	for _, f := range fields {
+		if present(f.Value) {
+			names = append(names, f.Name)
+		}
+	}
+	return names
+}
+
+func namesOf(fields []Named) []string {
+	names := make([]string, 0, len(fields))
+	for _, f := range fields {
+		names = append(names, f.Name)
+	}
+	return names
+}
+
+// ExactlyOneOf is a validator which will check whether exactly one of field Fields is present,
+// like "exactly one of card_token or bank_account".
+//
+// The validation error has the parameters "fields", the names of Fields, and "present",
+// the names of the present ones.
+type ExactlyOneOf struct {
+	Fields []Named
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *ExactlyOneOf) Validate() error {
+	if names := presentNames(v.Fields); len(names) != 1 {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, notExactlyOneMsg),
+			overridden: v.message != nil,
+			code:       CodeGroupNotExactlyOne,
+			params:     map[string]interface{}{"fields": namesOf(v.Fields), "present": names},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *ExactlyOneOf) OverrideMessage(msg string) *ExactlyOneOf {
+	v.message = &msg
+	return v
+}
+
+// AtLeastOneOf is a validator which will check whether at least one of field Fields is present,
+// like "at least one contact method".
+//
+// The validation error has the parameter "fields", the names of Fields.
+type AtLeastOneOf struct {
+	Fields []Named
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *AtLeastOneOf) Validate() error {
+	if len(presentNames(v.Fields)) == 0 {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, nonePresentMsg),
+			overridden: v.message != nil,
+			code:       CodeGroupNonePresent,
+			params:     map[string]interface{}{"fields": namesOf(v.Fields)},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *AtLeastOneOf) OverrideMessage(msg string) *AtLeastOneOf {
+	v.message = &msg
+	return v
+}
+
+// MutuallyExclusive is a validator which will check whether at most one of field Fields is present.
+//
+// The validation error has the parameters "fields", the names of Fields, and "present",
+// the names of the present ones.
+type MutuallyExclusive struct {
+	Fields []Named
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *MutuallyExclusive) Validate() error {
+	if names := presentNames(v.Fields); len(names) > 1 {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, manyPresentMsg),
+			overridden: v.message != nil,
+			code:       CodeGroupManyPresent,
+			params:     map[string]interface{}{"fields": namesOf(v.Fields), "present": names},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *MutuallyExclusive) OverrideMessage(msg string) *MutuallyExclusive {
+	v.message = &msg
+	return v
+}
+
+// RequiredWith is a validator which will check whether all of field Fields are present
+// if any of field With is present, like "shipping_address is required with ship".
+//
+// The validation error has the parameters "fields", the names of the missing Fields,
+// and "with", the names of the present With.
+type RequiredWith struct {
+	Fields []Named
+	With   []Named
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *RequiredWith) Validate() error {
+	with := presentNames(v.With)
+	if len(with) == 0 {
+		return nil
+	}
+
+	missing := []string{}
+	for _, f := range v.Fields {
+		if !present(f.Value) {
+			missing = append(missing, f.Name)
+		}
+	}
+	if len(missing) != 0 {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, missingRequiredMsg),
+			overridden: v.message != nil,
+			code:       CodeGroupMissingRequired,
+			params:     map[string]interface{}{"fields": missing, "with": with},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *RequiredWith) OverrideMessage(msg string) *RequiredWith {
+	v.message = &msg
+	return v
+}
@@ -0,0 +1,91 @@
+package validators_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/nauyey/guard"
+	"github.com/nauyey/guard/validators"
+)
+
+type address struct {
+	City string
+}
+
+func TestExactlyOneOf(t *testing.T) {
+	var nilAddress *address
+	tests := []struct {
+		fields  []validators.Named
+		present []string
+	}{
+		{[]validators.Named{{Name: "card_token", Value: "tok"}, {Name: "bank_account", Value: ""}}, nil},
+		{[]validators.Named{{Name: "card_token", Value: " 　"}, {Name: "bank_account", Value: nilAddress}}, []string{}},
+		{[]validators.Named{{Name: "card_token", Value: "tok"}, {Name: "bank_account", Value: &address{}}}, []string{"card_token", "bank_account"}},
+		{[]validators.Named{{Name: "at", Value: time.Time{}}, {Name: "count", Value: 0}, {Name: "flag", Value: true}}, nil},
+	}
+	for _, test := range tests {
+		err := (&validators.ExactlyOneOf{Fields: test.fields}).Validate()
+		if test.present == nil {
+			if err != nil {
+				t.Errorf("validators.ExactlyOneOf faild with err=%v", err)
+			}
+			continue
+		}
+		cErr, ok := err.(guard.CodedError)
+		if !ok || cErr.Code() != validators.CodeGroupNotExactlyOne || !reflect.DeepEqual(cErr.Params()["present"], test.present) {
+			t.Errorf("validators.ExactlyOneOf faild with err=%v", err)
+		}
+	}
+}
+
+func TestAtLeastOneOf(t *testing.T) {
+	if err := (&validators.AtLeastOneOf{Fields: []validators.Named{{Name: "email", Value: ""}, {Name: "phone", Value: "123"}}}).Validate(); err != nil {
+		t.Errorf("validators.AtLeastOneOf faild")
+	}
+	err := (&validators.AtLeastOneOf{Fields: []validators.Named{{Name: "email", Value: ""}, {Name: "phone", Value: nil}}}).Validate()
+	cErr, ok := err.(guard.CodedError)
+	if !ok || !reflect.DeepEqual(cErr.Params()["fields"], []string{"email", "phone"}) {
+		t.Errorf("validators.AtLeastOneOf faild with err=%v", err)
+	}
+}
+
+func TestMutuallyExclusive(t *testing.T) {
+	if err := (&validators.MutuallyExclusive{Fields: []validators.Named{{Name: "a", Value: ""}, {Name: "b", Value: ""}}}).Validate(); err != nil {
+		t.Errorf("validators.MutuallyExclusive faild")
+	}
+	if err := (&validators.MutuallyExclusive{Fields: []validators.Named{{Name: "a", Value: 1}, {Name: "b", Value: ""}}}).Validate(); err != nil {
+		t.Errorf("validators.MutuallyExclusive faild")
+	}
+	err := (&validators.MutuallyExclusive{Fields: []validators.Named{{Name: "a", Value: 1}, {Name: "b", Value: "x"}}}).OverrideMessage("override error message").Validate()
+	if err == nil || err.Error() != "override error message" {
+		t.Errorf("validators.MutuallyExclusive faild")
+	}
+}
+
+func TestRequiredWith(t *testing.T) {
+	shipping := &address{City: "Berlin"}
+
+	if err := (&validators.RequiredWith{
+		Fields: []validators.Named{{Name: "shipping_address", Value: nil}},
+		With:   []validators.Named{{Name: "ship", Value: false}},
+	}).Validate(); err != nil {
+		t.Errorf("validators.RequiredWith faild")
+	}
+	if err := (&validators.RequiredWith{
+		Fields: []validators.Named{{Name: "shipping_address", Value: shipping}},
+		With:   []validators.Named{{Name: "ship", Value: true}},
+	}).Validate(); err != nil {
+		t.Errorf("validators.RequiredWith faild")
+	}
+	err := (&validators.RequiredWith{
+		Fields: []validators.Named{{Name: "shipping_address", Value: (*address)(nil)}, {Name: "shipping_method", Value: "dhl"}},
+		With:   []validators.Named{{Name: "ship", Value: true}},
+	}).Validate()
+	cErr, ok := err.(guard.CodedError)
+	if !ok || cErr.Code() != validators.CodeGroupMissingRequired ||
+		!reflect.DeepEqual(cErr.Params()["fields"], []string{"shipping_address"}) ||
+		!reflect.DeepEqual(cErr.Params()["with"], []string{"ship"}) {
+		t.Errorf("validators.RequiredWith faild with err=%v", err)
+	}
+}
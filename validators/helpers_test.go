@@ -0,0 +1,14 @@
+package validators_test
+
+import "github.com/nauyey/guard"
+
+// codeOf returns the code of err, or the message of err if it isn't a guard.CodedError.
+func codeOf(err error) string {
+	if err == nil {
+		return ""
+	}
+	if cErr, ok := err.(guard.CodedError); ok {
+		return cErr.Code()
+	}
+	return err.Error()
+}
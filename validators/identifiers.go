@@ -0,0 +1,175 @@
+package validators
+
+import (
+	"strings"
+	"time"
+)
+
+// identifier validation error messages
+const (
+	uuidMalformedMsg      = "should be an UUID"
+	uuidWrongVersionMsg   = "UUID version is wrong"
+	uuidWrongVariantMsg   = "UUID variant is wrong"
+	ulidMalformedMsg      = "should be an ULID"
+	ulidTimeOutOfRangeMsg = "ULID time is out of range"
+)
+
+// identifier validation error codes
+const (
+	CodeUUIDMalformed      = "uuid.malformed"
+	CodeUUIDWrongVersion   = "uuid.wrong_version"
+	CodeUUIDWrongVariant   = "uuid.wrong_variant"
+	CodeULIDMalformed      = "ulid.malformed"
+	CodeULIDTimeOutOfRange = "ulid.time_out_of_range"
+)
+
+// UUID is a validator which will check whether the field Value is an UUID in the canonical form,
+// like "f81d4fae-7dec-11d0-a765-00a0c91e6bf6". Hex digits are case-insensitive.
+//
+// If field AllowBraced is true, the braced form "{f81d4fae-7dec-11d0-a765-00a0c91e6bf6}" is valid, too.
+// If field AllowURN is true, the URN form "urn:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6" is valid, too.
+//
+// If field Version isn't 0, the UUID must be of that version, like 4 or 7,
+// and of the variant defined by RFC 9562 (formerly RFC 4122).
+type UUID struct {
+	Value       string
+	Version     int
+	AllowBraced bool
+	AllowURN    bool
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *UUID) Validate() error {
+	s := v.Value
+	switch {
+	case v.AllowBraced && strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}"):
+		s = s[1 : len(s)-1]
+	case v.AllowURN && len(s) > 9 && strings.EqualFold(s[:9], "urn:uuid:"):
+		s = s[9:]
+	}
+
+	if !isCanonicalUUID(s) {
+		return v.error(CodeUUIDMalformed, uuidMalformedMsg)
+	}
+	if v.Version == 0 {
+		return nil
+	}
+
+	if version := fromHex(s[14]); version != v.Version {
+		return v.error(CodeUUIDWrongVersion, uuidWrongVersionMsg)
+	}
+	if variant := fromHex(s[19]); variant&0xc != 0x8 {
+		return v.error(CodeUUIDWrongVariant, uuidWrongVariantMsg)
+	}
+	return nil
+}
+
+func (v *UUID) error(code, msg string) error {
+	return &validationError{
+		msg:        returnDefaultStringIfNil(v.message, msg),
+		overridden: v.message != nil,
+		code:       code,
+		params:     map[string]interface{}{"version": v.Version},
+	}
+}
+
+// OverrideMessage overrides the validation error messages of current validator.
+// The codes of the validation errors stay distinct.
+func (v *UUID) OverrideMessage(msg string) *UUID {
+	v.message = &msg
+	return v
+}
+
+func isCanonicalUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if s[i] != '-' {
+				return false
+			}
+		default:
+			if fromHex(s[i]) < 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func fromHex(c byte) int {
+	switch {
+	case '0' <= c && c <= '9':
+		return int(c - '0')
+	case 'a' <= c && c <= 'f':
+		return int(c-'a') + 10
+	case 'A' <= c && c <= 'F':
+		return int(c-'A') + 10
+	}
+	return -1
+}
+
+// ULID is a validator which will check whether the field Value is an ULID, like "01ARZ3NDEKTSV4RRFFQ69G5FAV".
+//
+// An ULID is 26 characters of Crockford's base32, case-insensitive, and its first character
+// is at most "7" so that it fits in 128 bits.
+//
+// The time of the ULID, encoded by its first 10 characters, must be in range of field MinTime and MaxTime.
+// A zero MinTime or MaxTime means the range is unbounded on that end.
+type ULID struct {
+	Value   string
+	MinTime time.Time
+	MaxTime time.Time
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *ULID) Validate() error {
+	if len(v.Value) != 26 || v.Value[0] > '7' {
+		return &validationError{msg: returnDefaultStringIfNil(v.message, ulidMalformedMsg), overridden: v.message != nil, code: CodeULIDMalformed}
+	}
+
+	var ms int64
+	for i := 0; i < len(v.Value); i++ {
+		n := fromCrockford(v.Value[i])
+		if n < 0 {
+			return &validationError{msg: returnDefaultStringIfNil(v.message, ulidMalformedMsg), overridden: v.message != nil, code: CodeULIDMalformed}
+		}
+		if i < 10 {
+			ms = ms<<5 | int64(n)
+		}
+	}
+
+	t := time.UnixMilli(ms)
+	if !v.MinTime.IsZero() && t.Before(v.MinTime) || !v.MaxTime.IsZero() && t.After(v.MaxTime) {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, ulidTimeOutOfRangeMsg),
+			overridden: v.message != nil,
+			code:       CodeULIDTimeOutOfRange,
+			params:     map[string]interface{}{"min": v.MinTime, "max": v.MaxTime},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error messages of current validator.
+// The codes of the validation errors stay distinct.
+func (v *ULID) OverrideMessage(msg string) *ULID {
+	v.message = &msg
+	return v
+}
+
+// crockford is the alphabet of Crockford's base32.
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+func fromCrockford(c byte) int {
+	if 'a' <= c && c <= 'z' {
+		c -= 'a' - 'A'
+	}
+	return strings.IndexByte(crockford, c)
+}
@@ -0,0 +1,64 @@
+package validators_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nauyey/guard/validators"
+)
+
+func TestUUID(t *testing.T) {
+	tests := []struct {
+		validator *validators.UUID
+		code      string
+	}{
+		{&validators.UUID{Value: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"}, ""},
+		{&validators.UUID{Value: "F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6"}, ""},
+		{&validators.UUID{Value: "00000000-0000-0000-0000-000000000000"}, ""},
+		{&validators.UUID{Value: ""}, validators.CodeUUIDMalformed},
+		{&validators.UUID{Value: "f81d4fae7dec11d0a76500a0c91e6bf6"}, validators.CodeUUIDMalformed},
+		{&validators.UUID{Value: "f81d4fae-7dec-11d0-a765-00a0c91e6bfg"}, validators.CodeUUIDMalformed},
+		{&validators.UUID{Value: "{f81d4fae-7dec-11d0-a765-00a0c91e6bf6}"}, validators.CodeUUIDMalformed},
+		{&validators.UUID{Value: "{f81d4fae-7dec-11d0-a765-00a0c91e6bf6}", AllowBraced: true}, ""},
+		{&validators.UUID{Value: "urn:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6"}, validators.CodeUUIDMalformed},
+		{&validators.UUID{Value: "URN:UUID:f81d4fae-7dec-11d0-a765-00a0c91e6bf6", AllowURN: true}, ""},
+		{&validators.UUID{Value: "919108f7-52d1-4320-9bac-f847db4148a8", Version: 4}, ""},
+		{&validators.UUID{Value: "919108f7-52d1-4320-9bac-f847db4148a8", Version: 7}, validators.CodeUUIDWrongVersion},
+		{&validators.UUID{Value: "017f22e2-79b0-7cc3-98c4-dc0c0c07398f", Version: 7}, ""},
+		{&validators.UUID{Value: "919108f7-52d1-4320-cbac-f847db4148a8", Version: 4}, validators.CodeUUIDWrongVariant},
+		{&validators.UUID{Value: "00000000-0000-0000-0000-000000000000", Version: 4}, validators.CodeUUIDWrongVersion},
+	}
+
+	for _, test := range tests {
+		if code := codeOf(test.validator.Validate()); code != test.code {
+			t.Errorf("validators.UUID faild with value=%q, code=%q, want code=%q", test.validator.Value, code, test.code)
+		}
+	}
+}
+
+func TestULID(t *testing.T) {
+	// 01ARZ3NDEK is 1469922850259 milliseconds since the Unix epoch
+	ulidTime := time.UnixMilli(1469922850259)
+
+	tests := []struct {
+		validator *validators.ULID
+		code      string
+	}{
+		{&validators.ULID{Value: "01ARZ3NDEKTSV4RRFFQ69G5FAV"}, ""},
+		{&validators.ULID{Value: "01arz3ndektsv4rrffq69g5fav"}, ""},
+		{&validators.ULID{Value: "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"}, ""},
+		{&validators.ULID{Value: "80000000000000000000000000"}, validators.CodeULIDMalformed},
+		{&validators.ULID{Value: "01ARZ3NDEKTSV4RRFFQ69G5FA"}, validators.CodeULIDMalformed},
+		{&validators.ULID{Value: "01ARZ3NDEKTSV4RRFFQ69G5FAU"}, validators.CodeULIDMalformed},
+		{&validators.ULID{Value: "01ARZ3NDEKTSV4RRFFQ69G5FAV", MinTime: ulidTime}, ""},
+		{&validators.ULID{Value: "01ARZ3NDEKTSV4RRFFQ69G5FAV", MinTime: ulidTime.Add(time.Millisecond)}, validators.CodeULIDTimeOutOfRange},
+		{&validators.ULID{Value: "01ARZ3NDEKTSV4RRFFQ69G5FAV", MaxTime: ulidTime.Add(-time.Millisecond)}, validators.CodeULIDTimeOutOfRange},
+		{&validators.ULID{Value: "01ARZ3NDEKTSV4RRFFQ69G5FAV", MinTime: ulidTime.Add(-time.Hour), MaxTime: ulidTime.Add(time.Hour)}, ""},
+	}
+
+	for _, test := range tests {
+		if code := codeOf(test.validator.Validate()); code != test.code {
+			t.Errorf("validators.ULID faild with value=%q, code=%q, want code=%q", test.validator.Value, code, test.code)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package validators
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/nauyey/guard"
+)
+
+// map validation error messages
+const (
+	missingKeysMsg    = "should contain the keys"
+	keyNotAllowedMsg  = "keys should be in"
+	keyNotMatchedMsg  = "keys should match the pattern"
+	tooFewEntriesMsg  = "too few entries"
+	tooManyEntriesMsg = "too many entries"
+)
+
+// map validation error codes
+const (
+	CodeMapMissingKeys   = "map.missing_keys"
+	CodeMapKeyNotAllowed = "map.key_not_allowed"
+	CodeMapKeyNotMatched = "map.key_not_matched"
+	CodeMapTooFew        = "map.too_few"
+	CodeMapTooMany       = "map.too_many"
+)
+
+// RequiredKeys is a validator which will check whether the map field Value contains all of field Keys.
+// The missing keys are reported in the order of Keys.
+type RequiredKeys[K cmp.Ordered, V any] struct {
+	Value map[K]V
+	Keys  []K
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *RequiredKeys[K, V]) Validate() error {
+	missing := []K{}
+	for _, key := range v.Keys {
+		if _, ok := v.Value[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+
+	if len(missing) != 0 {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, missingKeysMsg),
+			overridden: v.message != nil,
+			code:       CodeMapMissingKeys,
+			params:     map[string]interface{}{"keys": v.Keys, "missing": missing},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *RequiredKeys[K, V]) OverrideMessage(msg string) *RequiredKeys[K, V] {
+	v.message = &msg
+	return v
+}
+
+// AllowedKeys is a validator which will check whether every key of the map field Value is in field Keys.
+// The keys which aren't allowed are reported in sorted order.
+type AllowedKeys[K cmp.Ordered, V any] struct {
+	Value map[K]V
+	Keys  []K
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *AllowedKeys[K, V]) Validate() error {
+	invalid := []K{}
+	for _, key := range sortedKeys(v.Value) {
+		if !slices.Contains(v.Keys, key) {
+			invalid = append(invalid, key)
+		}
+	}
+
+	if len(invalid) != 0 {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, keyNotAllowedMsg),
+			overridden: v.message != nil,
+			code:       CodeMapKeyNotAllowed,
+			params:     map[string]interface{}{"allowed": v.Keys, "invalid": invalid},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *AllowedKeys[K, V]) OverrideMessage(msg string) *AllowedKeys[K, V] {
+	v.message = &msg
+	return v
+}
+
+// KeysMatch is a validator which will check whether every key of the map field Value matches a regular expression.
+//
+// The regular expression is field Regexp, or the compiled field Pattern if Regexp is nil,
+// the same as StringMatches. The keys which don't match are reported in sorted order.
+type KeysMatch[V any] struct {
+	Value   map[string]V
+	Pattern string
+	Regexp  *regexp.Regexp
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *KeysMatch[V]) Validate() error {
+	re, err := regexpOf(v.Regexp, v.Pattern)
+	if err != nil {
+		return err
+	}
+
+	invalid := []string{}
+	for _, key := range sortedKeys(v.Value) {
+		if !re.MatchString(key) {
+			invalid = append(invalid, key)
+		}
+	}
+
+	if len(invalid) != 0 {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, keyNotMatchedMsg),
+			overridden: v.message != nil,
+			code:       CodeMapKeyNotMatched,
+			params:     map[string]interface{}{"pattern": re.String(), "invalid": invalid},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *KeysMatch[V]) OverrideMessage(msg string) *KeysMatch[V] {
+	v.message = &msg
+	return v
+}
+
+// MinEntries is a validator which will check whether the map field Value has at least field Min entries.
+type MinEntries[K comparable, V any] struct {
+	Value map[K]V
+	Min   int
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *MinEntries[K, V]) Validate() error {
+	if len(v.Value) < v.Min {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, tooFewEntriesMsg),
+			overridden: v.message != nil,
+			code:       CodeMapTooFew,
+			params:     map[string]interface{}{"min": v.Min},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *MinEntries[K, V]) OverrideMessage(msg string) *MinEntries[K, V] {
+	v.message = &msg
+	return v
+}
+
+// MaxEntries is a validator which will check whether the map field Value has at most field Max entries.
+type MaxEntries[K comparable, V any] struct {
+	Value map[K]V
+	Max   int
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *MaxEntries[K, V]) Validate() error {
+	if len(v.Value) > v.Max {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, tooManyEntriesMsg),
+			overridden: v.message != nil,
+			code:       CodeMapTooMany,
+			params:     map[string]interface{}{"max": v.Max},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *MaxEntries[K, V]) OverrideMessage(msg string) *MaxEntries[K, V] {
+	v.message = &msg
+	return v
+}
+
+// EachEntry is a validator which will validate every entry of the map field Value.
+//
+// The key of an entry is validated by the validator returned by field Key,
+// and the value by the validator returned by field Validator. Either of them may be nil,
+// and they may return nil for a valid entry.
+//
+// Entries are validated in sorted key order, and their validation errors are bound to the quoted key,
+// so guard.Field("labels", &validators.EachEntry[string, string]{...}) reports errors like `labels["env"]`.
+type EachEntry[K cmp.Ordered, V any] struct {
+	Value     map[K]V
+	Key       func(key K) guard.Validator
+	Validator func(key K, value V) guard.Validator
+}
+
+// Validate implements the guard.Validator interface
+func (v *EachEntry[K, V]) Validate() error {
+	return v.ValidateContext(context.Background())
+}
+
+// ValidateContext implements the guard.ContextValidator interface
+func (v *EachEntry[K, V]) ValidateContext(ctx context.Context) error {
+	vs := make([]guard.Validator, 0, len(v.Value))
+	for _, key := range sortedKeys(v.Value) {
+		name := "[" + strconv.Quote(fmt.Sprint(key)) + "]"
+		if v.Key != nil {
+			if kv := v.Key(key); kv != nil {
+				vs = append(vs, guard.Field(name, kv))
+			}
+		}
+		if v.Validator != nil {
+			if ev := v.Validator(key, v.Value[key]); ev != nil {
+				vs = append(vs, guard.Field(name, ev))
+			}
+		}
+	}
+	return guard.ValidateContext(ctx, vs...)
+}
+
+// sortedKeys returns the keys of m in sorted order, so that map validations are deterministic.
+func sortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
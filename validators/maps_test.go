@@ -0,0 +1,129 @@
+package validators_test
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/nauyey/guard"
+	"github.com/nauyey/guard/validators"
+)
+
+func TestRequiredKeys(t *testing.T) {
+	labels := map[string]string{"env": "prod", "team": "core"}
+
+	if err := (&validators.RequiredKeys[string, string]{Value: labels, Keys: []string{"env", "team"}}).Validate(); err != nil {
+		t.Errorf("validators.RequiredKeys faild")
+	}
+	err := (&validators.RequiredKeys[string, string]{Value: labels, Keys: []string{"owner", "env", "app"}}).Validate()
+	cErr, ok := err.(guard.CodedError)
+	if !ok || cErr.Code() != validators.CodeMapMissingKeys || !reflect.DeepEqual(cErr.Params()["missing"], []string{"owner", "app"}) {
+		t.Errorf("validators.RequiredKeys faild with err=%v", err)
+	}
+}
+
+func TestAllowedKeys(t *testing.T) {
+	allowed := []string{"env", "team"}
+
+	if err := (&validators.AllowedKeys[string, int]{Value: map[string]int{"env": 1}, Keys: allowed}).Validate(); err != nil {
+		t.Errorf("validators.AllowedKeys faild")
+	}
+	err := (&validators.AllowedKeys[string, int]{Value: map[string]int{"z": 1, "env": 2, "a": 3, "m": 4}, Keys: allowed}).Validate()
+	cErr, ok := err.(guard.CodedError)
+	if !ok || !reflect.DeepEqual(cErr.Params()["invalid"], []string{"a", "m", "z"}) {
+		t.Errorf("validators.AllowedKeys faild with err=%v", err)
+	}
+}
+
+func TestKeysMatch(t *testing.T) {
+	labels := map[string]string{"env": "prod", "Team": "core", "app-name": "guard", "1st": "x"}
+
+	err := (&validators.KeysMatch[string]{Value: labels, Pattern: `^[a-z][a-z-]*$`}).Validate()
+	cErr, ok := err.(guard.CodedError)
+	if !ok || cErr.Code() != validators.CodeMapKeyNotMatched || !reflect.DeepEqual(cErr.Params()["invalid"], []string{"1st", "Team"}) {
+		t.Errorf("validators.KeysMatch faild with err=%v", err)
+	}
+	if err := (&validators.KeysMatch[string]{Value: labels, Regexp: regexp.MustCompile(`^\w`)}).Validate(); err != nil {
+		t.Errorf("validators.KeysMatch faild")
+	}
+
+	// test invalid pattern
+	err = (&validators.KeysMatch[string]{Value: labels, Pattern: `(`}).Validate()
+	if _, ok := err.(guard.Error); err == nil || ok {
+		t.Errorf("validators.KeysMatch faild with err=%v", err)
+	}
+}
+
+func TestMinEntries(t *testing.T) {
+	if err := (&validators.MinEntries[string, int]{Value: map[string]int{"a": 1}, Min: 1}).Validate(); err != nil {
+		t.Errorf("validators.MinEntries faild")
+	}
+	if err := (&validators.MinEntries[string, int]{Value: nil, Min: 1}).Validate(); err == nil {
+		t.Errorf("validators.MinEntries faild")
+	}
+}
+
+func TestMaxEntries(t *testing.T) {
+	if err := (&validators.MaxEntries[string, int]{Value: map[string]int{"a": 1}, Max: 1}).Validate(); err != nil {
+		t.Errorf("validators.MaxEntries faild")
+	}
+	err := (&validators.MaxEntries[string, int]{Value: map[string]int{"a": 1, "b": 2}, Max: 1}).OverrideMessage("override error message").Validate()
+	if err == nil || err.Error() != "override error message" {
+		t.Errorf("validators.MaxEntries faild")
+	}
+}
+
+func TestEachEntry(t *testing.T) {
+	labels := map[string]string{"z": "", "env": "prod", "a": " ", "Bad": "x", "m": "ok"}
+
+	for i := 0; i < 10; i++ {
+		err := guard.Validate(guard.Field("labels", &validators.EachEntry[string, string]{
+			Value: labels,
+			Key: func(key string) guard.Validator {
+				return &validators.StringMatches{Value: key, Pattern: `^[a-z]+$`}
+			},
+			Validator: func(key string, value string) guard.Validator {
+				return &validators.StringNotBlank{Value: value}
+			},
+		}))
+		errs, ok := err.(guard.Errors)
+		if !ok {
+			t.Fatalf("validators.EachEntry faild to return err(type guard.Errors)")
+		}
+
+		var got []string
+		for _, vErr := range errs.ValidationErrors() {
+			got = append(got, vErr.(guard.FieldError).Field()+":"+codeOf(errors.Unwrap(vErr)))
+		}
+		want := []string{
+			`labels["Bad"]:` + validators.CodeStringNotMatched,
+			`labels["a"]:` + validators.CodeStringBlank,
+			`labels["z"]:` + validators.CodeStringBlank,
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("validators.EachEntry faild with errors=%v, want errors=%v", got, want)
+		}
+	}
+
+	// test keys with dots, brackets and quotes
+	err := guard.Validate(guard.Field("labels", &validators.EachEntry[string, string]{
+		Value: map[string]string{"app.kubernetes.io/name": "", "": "", `[0]"`: ""},
+		Validator: func(key string, value string) guard.Validator {
+			return &validators.StringNotBlank{Value: value}
+		},
+	}))
+	var got []string
+	for _, vErr := range err.(guard.Errors).ValidationErrors() {
+		got = append(got, vErr.(guard.FieldError).Field())
+	}
+	want := []string{`labels[""]`, `labels["[0]\""]`, `labels["app.kubernetes.io/name"]`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("validators.EachEntry faild with fields=%v, want fields=%v", got, want)
+	}
+
+	// test without any entry validator
+	if err := (&validators.EachEntry[int, string]{Value: map[int]string{1: ""}}).Validate(); err != nil {
+		t.Errorf("validators.EachEntry faild")
+	}
+}
@@ -0,0 +1,231 @@
+package validators
+
+import "net/netip"
+
+// network validation error messages
+const (
+	ipMalformedMsg      = "should be an IP address"
+	ipWrongVersionMsg   = "IP address version is wrong"
+	ipNotInPrefixesMsg  = "IP address isn't in the allowed networks"
+	ipSpecialMsg        = "IP address shouldn't be in a special range"
+	cidrMalformedMsg    = "should be a CIDR prefix"
+	cidrWrongVersionMsg = "CIDR prefix version is wrong"
+	cidrTooShortMsg     = "CIDR prefix length too short"
+	cidrTooLongMsg      = "CIDR prefix length too long"
+	cidrNotMaskedMsg    = "CIDR prefix shouldn't have host bits set"
+)
+
+// network validation error codes
+const (
+	CodeIPMalformed      = "ip.malformed"
+	CodeIPWrongVersion   = "ip.wrong_version"
+	CodeIPNotInPrefixes  = "ip.not_in_prefixes"
+	CodeIPSpecial        = "ip.special"
+	CodeCIDRMalformed    = "cidr.malformed"
+	CodeCIDRWrongVersion = "cidr.wrong_version"
+	CodeCIDRTooShort     = "cidr.too_short"
+	CodeCIDRTooLong      = "cidr.too_long"
+	CodeCIDRNotMasked    = "cidr.not_masked"
+)
+
+// IP is a validator which will check whether the field Value is an IPv4 or IPv6 address parsed by net/netip.
+//
+// If field Version is 4 or 6, the address must be of that version.
+// IPv4-mapped IPv6 addresses, like "::ffff:1.2.3.4", are IPv6 addresses.
+type IP struct {
+	Value   string
+	Version int
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *IP) Validate() error {
+	addr, err := netip.ParseAddr(v.Value)
+	if err != nil {
+		return &validationError{msg: returnDefaultStringIfNil(v.message, ipMalformedMsg), overridden: v.message != nil, code: CodeIPMalformed}
+	}
+	if !matchIPVersion(addr, v.Version) {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, ipWrongVersionMsg),
+			overridden: v.message != nil,
+			code:       CodeIPWrongVersion,
+			params:     map[string]interface{}{"version": v.Version},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *IP) OverrideMessage(msg string) *IP {
+	v.message = &msg
+	return v
+}
+
+// CIDR is a validator which will check whether the field Value is a CIDR prefix parsed by net/netip, like "10.0.0.0/8".
+//
+// If field Version is 4 or 6, the prefix must be of that version.
+// The prefix length must be in range of field MinBits and MaxBits. MaxBits is unlimited if it's 0.
+// If field RequireMasked is true, the prefix mustn't have host bits set, so "10.0.0.1/8" is invalid.
+type CIDR struct {
+	Value         string
+	Version       int
+	MinBits       int
+	MaxBits       int
+	RequireMasked bool
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *CIDR) Validate() error {
+	prefix, err := netip.ParsePrefix(v.Value)
+	if err != nil {
+		return v.error(CodeCIDRMalformed, cidrMalformedMsg, nil)
+	}
+	if !matchIPVersion(prefix.Addr(), v.Version) {
+		return v.error(CodeCIDRWrongVersion, cidrWrongVersionMsg, map[string]interface{}{"version": v.Version})
+	}
+	if prefix.Bits() < v.MinBits {
+		return v.error(CodeCIDRTooShort, cidrTooShortMsg, map[string]interface{}{"min": v.MinBits, "max": v.MaxBits})
+	}
+	if v.MaxBits > 0 && prefix.Bits() > v.MaxBits {
+		return v.error(CodeCIDRTooLong, cidrTooLongMsg, map[string]interface{}{"min": v.MinBits, "max": v.MaxBits})
+	}
+	if v.RequireMasked && prefix.Masked() != prefix {
+		return v.error(CodeCIDRNotMasked, cidrNotMaskedMsg, map[string]interface{}{"masked": prefix.Masked().String()})
+	}
+	return nil
+}
+
+func (v *CIDR) error(code, msg string, params map[string]interface{}) error {
+	return &validationError{msg: returnDefaultStringIfNil(v.message, msg), overridden: v.message != nil, code: code, params: params}
+}
+
+// OverrideMessage overrides the validation error messages of current validator.
+// The codes of the validation errors stay distinct.
+func (v *CIDR) OverrideMessage(msg string) *CIDR {
+	v.message = &msg
+	return v
+}
+
+// IPInPrefixes is a validator which will check whether the field Value is an IP address within one of field Prefixes.
+//
+// IPv4-mapped IPv6 addresses are compared as IPv4 addresses.
+type IPInPrefixes struct {
+	Value    string
+	Prefixes []netip.Prefix
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *IPInPrefixes) Validate() error {
+	addr, err := netip.ParseAddr(v.Value)
+	if err != nil {
+		return &validationError{msg: returnDefaultStringIfNil(v.message, ipMalformedMsg), overridden: v.message != nil, code: CodeIPMalformed}
+	}
+
+	addr = addr.Unmap().WithZone("")
+	for _, prefix := range v.Prefixes {
+		if prefix.Contains(addr) {
+			return nil
+		}
+	}
+
+	prefixes := make([]string, 0, len(v.Prefixes))
+	for _, prefix := range v.Prefixes {
+		prefixes = append(prefixes, prefix.String())
+	}
+	return &validationError{
+		msg:        returnDefaultStringIfNil(v.message, ipNotInPrefixesMsg),
+		overridden: v.message != nil,
+		code:       CodeIPNotInPrefixes,
+		params:     map[string]interface{}{"prefixes": prefixes},
+	}
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *IPInPrefixes) OverrideMessage(msg string) *IPInPrefixes {
+	v.message = &msg
+	return v
+}
+
+// IPRange is a set of special IP address ranges.
+type IPRange int
+
+// special IP address ranges
+const (
+	IPLoopback IPRange = 1 << iota
+	IPMulticast
+	IPLinkLocal
+	IPPrivate
+	IPUnspecified
+
+	IPSpecial = IPLoopback | IPMulticast | IPLinkLocal | IPPrivate | IPUnspecified
+)
+
+// ipRangeNames are the names of special IP address ranges, which are used as error parameters.
+var ipRangeNames = []struct {
+	r    IPRange
+	name string
+	in   func(netip.Addr) bool
+}{
+	{IPLoopback, "loopback", netip.Addr.IsLoopback},
+	{IPMulticast, "multicast", netip.Addr.IsMulticast},
+	{IPLinkLocal, "link_local", func(addr netip.Addr) bool { return addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() }},
+	{IPPrivate, "private", netip.Addr.IsPrivate},
+	{IPUnspecified, "unspecified", netip.Addr.IsUnspecified},
+}
+
+// IPNotSpecial is a validator which will check whether the field Value is an IP address out of the special ranges.
+//
+// The special ranges are field Reject, or all of them (IPSpecial) if Reject is 0.
+// IPv4-mapped IPv6 addresses are checked as IPv4 addresses.
+type IPNotSpecial struct {
+	Value  string
+	Reject IPRange
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *IPNotSpecial) Validate() error {
+	addr, err := netip.ParseAddr(v.Value)
+	if err != nil {
+		return &validationError{msg: returnDefaultStringIfNil(v.message, ipMalformedMsg), overridden: v.message != nil, code: CodeIPMalformed}
+	}
+
+	reject := v.Reject
+	if reject == 0 {
+		reject = IPSpecial
+	}
+	addr = addr.Unmap()
+	for _, r := range ipRangeNames {
+		if reject&r.r != 0 && r.in(addr) {
+			return &validationError{
+				msg:        returnDefaultStringIfNil(v.message, ipSpecialMsg),
+				overridden: v.message != nil,
+				code:       CodeIPSpecial,
+				params:     map[string]interface{}{"range": r.name},
+			}
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *IPNotSpecial) OverrideMessage(msg string) *IPNotSpecial {
+	v.message = &msg
+	return v
+}
+
+func matchIPVersion(addr netip.Addr, version int) bool {
+	switch version {
+	case 4:
+		return addr.Is4()
+	case 6:
+		return addr.Is6()
+	}
+	return true
+}
@@ -0,0 +1,116 @@
+package validators_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/nauyey/guard"
+	"github.com/nauyey/guard/validators"
+)
+
+func TestIP(t *testing.T) {
+	tests := []struct {
+		validator *validators.IP
+		code      string
+	}{
+		{&validators.IP{Value: "192.168.0.1"}, ""},
+		{&validators.IP{Value: "2001:db8::1"}, ""},
+		{&validators.IP{Value: "fe80::1%eth0"}, ""},
+		{&validators.IP{Value: ""}, validators.CodeIPMalformed},
+		{&validators.IP{Value: "256.0.0.1"}, validators.CodeIPMalformed},
+		{&validators.IP{Value: "example.com"}, validators.CodeIPMalformed},
+		{&validators.IP{Value: "192.168.0.1", Version: 4}, ""},
+		{&validators.IP{Value: "192.168.0.1", Version: 6}, validators.CodeIPWrongVersion},
+		{&validators.IP{Value: "::ffff:192.168.0.1", Version: 4}, validators.CodeIPWrongVersion},
+		{&validators.IP{Value: "2001:db8::1", Version: 6}, ""},
+	}
+
+	for _, test := range tests {
+		if code := codeOf(test.validator.Validate()); code != test.code {
+			t.Errorf("validators.IP faild with value=%q, code=%q, want code=%q", test.validator.Value, code, test.code)
+		}
+	}
+}
+
+func TestCIDR(t *testing.T) {
+	tests := []struct {
+		validator *validators.CIDR
+		code      string
+	}{
+		{&validators.CIDR{Value: "10.0.0.0/8"}, ""},
+		{&validators.CIDR{Value: "2001:db8::/32"}, ""},
+		{&validators.CIDR{Value: "10.0.0.0"}, validators.CodeCIDRMalformed},
+		{&validators.CIDR{Value: "10.0.0.0/33"}, validators.CodeCIDRMalformed},
+		{&validators.CIDR{Value: "2001:db8::/32", Version: 4}, validators.CodeCIDRWrongVersion},
+		{&validators.CIDR{Value: "10.0.0.0/8", MinBits: 16, MaxBits: 24}, validators.CodeCIDRTooShort},
+		{&validators.CIDR{Value: "10.0.0.0/28", MinBits: 16, MaxBits: 24}, validators.CodeCIDRTooLong},
+		{&validators.CIDR{Value: "10.0.0.0/20", MinBits: 16, MaxBits: 24}, ""},
+		{&validators.CIDR{Value: "10.0.0.1/8"}, ""},
+		{&validators.CIDR{Value: "10.0.0.1/8", RequireMasked: true}, validators.CodeCIDRNotMasked},
+	}
+
+	for _, test := range tests {
+		if code := codeOf(test.validator.Validate()); code != test.code {
+			t.Errorf("validators.CIDR faild with value=%q, code=%q, want code=%q", test.validator.Value, code, test.code)
+		}
+	}
+}
+
+func TestIPInPrefixes(t *testing.T) {
+	prefixes := []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("2001:db8::/32"),
+	}
+
+	tests := []struct {
+		value string
+		code  string
+	}{
+		{"10.1.2.3", ""},
+		{"::ffff:10.1.2.3", ""},
+		{"2001:db8::1", ""},
+		{"11.0.0.1", validators.CodeIPNotInPrefixes},
+		{"2001:db9::1", validators.CodeIPNotInPrefixes},
+		{"10.1.2", validators.CodeIPMalformed},
+	}
+
+	for _, test := range tests {
+		err := (&validators.IPInPrefixes{Value: test.value, Prefixes: prefixes}).Validate()
+		if code := codeOf(err); code != test.code {
+			t.Errorf("validators.IPInPrefixes faild with value=%q, code=%q, want code=%q", test.value, code, test.code)
+		}
+	}
+}
+
+func TestIPNotSpecial(t *testing.T) {
+	tests := []struct {
+		validator *validators.IPNotSpecial
+		code      string
+	}{
+		{&validators.IPNotSpecial{Value: "8.8.8.8"}, ""},
+		{&validators.IPNotSpecial{Value: "2606:4700::1111"}, ""},
+		{&validators.IPNotSpecial{Value: "127.0.0.1"}, validators.CodeIPSpecial},
+		{&validators.IPNotSpecial{Value: "::1"}, validators.CodeIPSpecial},
+		{&validators.IPNotSpecial{Value: "224.0.0.1"}, validators.CodeIPSpecial},
+		{&validators.IPNotSpecial{Value: "169.254.169.254"}, validators.CodeIPSpecial},
+		{&validators.IPNotSpecial{Value: "fe80::1"}, validators.CodeIPSpecial},
+		{&validators.IPNotSpecial{Value: "192.168.1.1"}, validators.CodeIPSpecial},
+		{&validators.IPNotSpecial{Value: "::ffff:192.168.1.1"}, validators.CodeIPSpecial},
+		{&validators.IPNotSpecial{Value: "0.0.0.0"}, validators.CodeIPSpecial},
+		{&validators.IPNotSpecial{Value: "192.168.1.1", Reject: validators.IPLoopback}, ""},
+		{&validators.IPNotSpecial{Value: "127.0.0.1", Reject: validators.IPLoopback | validators.IPPrivate}, validators.CodeIPSpecial},
+		{&validators.IPNotSpecial{Value: "localhost"}, validators.CodeIPMalformed},
+	}
+
+	for _, test := range tests {
+		if code := codeOf(test.validator.Validate()); code != test.code {
+			t.Errorf("validators.IPNotSpecial faild with value=%q, code=%q, want code=%q", test.validator.Value, code, test.code)
+		}
+	}
+
+	// test error params
+	err := (&validators.IPNotSpecial{Value: "10.0.0.1"}).Validate()
+	if cErr, ok := err.(guard.CodedError); !ok || cErr.Params()["range"] != "private" {
+		t.Errorf("validators.IPNotSpecial faild")
+	}
+}
@@ -8,7 +8,15 @@ const (
 	notNilMsg = "shouldn't be nil"
 )
 
+// not nil validation error codes
+const (
+	CodeNil = "value.nil"
+)
+
 // NotNil is a validator which will check whether the field Value is not nil.
+//
+// Value is nil if it's a nil interface, or a nil chan, func, interface, map, pointer, slice or unsafe pointer,
+// including a typed nil like (*T)(nil) or map[string]string(nil).
 type NotNil struct {
 	Value interface{}
 
@@ -17,9 +25,8 @@ type NotNil struct {
 
 // Validate implements the guard.Validator interface
 func (v *NotNil) Validate() error {
-	vpv := reflect.ValueOf(v.Value)
-	if vpv.Kind() == reflect.Invalid || (vpv.Kind() == reflect.Ptr) && vpv.IsNil() {
-		return &validationError{returnDefaultStringIfNil(v.message, notNilMsg)}
+	if isNil(reflect.ValueOf(v.Value)) {
+		return &validationError{msg: returnDefaultStringIfNil(v.message, notNilMsg), overridden: v.message != nil, code: CodeNil}
 	}
 
 	return nil
@@ -31,6 +31,15 @@ func TestNotNil(t *testing.T) {
 		t.Errorf("validators.NotNil faild")
 	}
 
+	for _, value := range nilValues {
+		if err := (&validators.NotNil{Value: value}).Validate(); err == nil {
+			t.Errorf("validators.NotNil faild with value=%#v", value)
+		}
+	}
+	if err := (&validators.NotNil{Value: map[string]string{}}).Validate(); err != nil {
+		t.Errorf("validators.NotNil faild")
+	}
+
 	// test override error message
 	err := (&validators.NotNil{Value: nilTest}).Validate()
 	if err == nil || err.Error() != "shouldn't be nil" {
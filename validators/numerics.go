@@ -2,17 +2,37 @@ package validators
 
 // numeric validation error messages
 const (
-	isOddMsg                   = "should be odd"
-	isEvenMsg                  = "should be even"
-	intGreaterThanMsg          = "should be great than"
-	intGreaterThanOrEqualToMsg = "should be great than or equal to"
-	intEqualToMsg              = "should equal to"
-	intLessThanMsg             = "should be less than"
-	intLessThanOrEqualToMsg    = "should be less than or equal to"
-	intInRangeLeftMsg          = "should be greater than or equal to left"
-	intInRangeRightMsg         = "should be less than or equal to right"
+	isOddMsg                = "should be odd"
+	isEvenMsg               = "should be even"
+	greaterThanMsg          = "should be great than"
+	greaterThanOrEqualToMsg = "should be great than or equal to"
+	equalToMsg              = "should equal to"
+	lessThanMsg             = "should be less than"
+	lessThanOrEqualToMsg    = "should be less than or equal to"
+	inRangeLeftMsg          = "should be greater than or equal to left"
+	inRangeRightMsg         = "should be less than or equal to right"
 )
 
+// numeric validation error codes
+const (
+	CodeNotOdd                  = "number.not_odd"
+	CodeNotEven                 = "number.not_even"
+	CodeNotGreaterThan          = "number.not_greater_than"
+	CodeNotGreaterThanOrEqualTo = "number.not_greater_than_or_equal_to"
+	CodeNotEqualTo              = "number.not_equal_to"
+	CodeNotLessThan             = "number.not_less_than"
+	CodeNotLessThanOrEqualTo    = "number.not_less_than_or_equal_to"
+	CodeOutOfLeftRange          = "number.out_of_left_range"
+	CodeOutOfRightRange         = "number.out_of_right_range"
+)
+
+// Number is the constraint of the numeric types supported by the generic numeric validators.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
 // IsOdd is a validator which will check whether the field Value is odd.
 type IsOdd struct {
 	Value int
@@ -23,7 +43,7 @@ type IsOdd struct {
 // Validate implements the guard.Validator interface
 func (v *IsOdd) Validate() error {
 	if v.Value%2 == 0 {
-		return &validationError{returnDefaultStringIfNil(v.message, isOddMsg)}
+		return &validationError{msg: returnDefaultStringIfNil(v.message, isOddMsg), overridden: v.message != nil, code: CodeNotOdd}
 	}
 	return nil
 }
@@ -44,7 +64,7 @@ type IsEven struct {
 // Validate implements the guard.Validator interface
 func (v *IsEven) Validate() error {
 	if v.Value%2 != 0 {
-		return &validationError{returnDefaultStringIfNil(v.message, isEvenMsg)}
+		return &validationError{msg: returnDefaultStringIfNil(v.message, isEvenMsg), overridden: v.message != nil, code: CodeNotEven}
 	}
 	return nil
 }
@@ -55,145 +75,210 @@ func (v *IsEven) OverrideMessage(msg string) *IsEven {
 	return v
 }
 
-// IntGreaterThan is a validator which will check whether the field Value is greater than field Target.
-type IntGreaterThan struct {
-	Value  int
-	Target int
+// GreaterThan is a validator which will check whether the field Value is greater than field Target.
+//
+// A NaN Value or Target is always invalid.
+type GreaterThan[T Number] struct {
+	Value  T
+	Target T
 
 	message *string
 }
 
 // Validate implements the guard.Validator interface
-func (v *IntGreaterThan) Validate() error {
-	if v.Value <= v.Target {
-		return &validationError{returnDefaultStringIfNil(v.message, intGreaterThanMsg)}
+func (v *GreaterThan[T]) Validate() error {
+	if !(v.Value > v.Target) {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, greaterThanMsg),
+			overridden: v.message != nil,
+			code:       CodeNotGreaterThan,
+			params:     map[string]interface{}{"target": v.Target},
+		}
 	}
 	return nil
 }
 
 // OverrideMessage overrides the validation error message of current validator
-func (v *IntGreaterThan) OverrideMessage(msg string) *IntGreaterThan {
+func (v *GreaterThan[T]) OverrideMessage(msg string) *GreaterThan[T] {
 	v.message = &msg
 	return v
 }
 
-// IntGreaterThanOrEqualTo is a validator which will check whether the field Value is greater than or equal to field Target.
-type IntGreaterThanOrEqualTo struct {
-	Value  int
-	Target int
+// IntGreaterThan is a validator which will check whether the int field Value is greater than field Target.
+type IntGreaterThan = GreaterThan[int]
+
+// GreaterThanOrEqualTo is a validator which will check whether the field Value is greater than or equal to field Target.
+//
+// A NaN Value or Target is always invalid.
+type GreaterThanOrEqualTo[T Number] struct {
+	Value  T
+	Target T
 
 	message *string
 }
 
 // Validate implements the guard.Validator interface
-func (v *IntGreaterThanOrEqualTo) Validate() error {
-	if v.Value < v.Target {
-		return &validationError{returnDefaultStringIfNil(v.message, intGreaterThanOrEqualToMsg)}
+func (v *GreaterThanOrEqualTo[T]) Validate() error {
+	if !(v.Value >= v.Target) {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, greaterThanOrEqualToMsg),
+			overridden: v.message != nil,
+			code:       CodeNotGreaterThanOrEqualTo,
+			params:     map[string]interface{}{"target": v.Target},
+		}
 	}
 	return nil
 }
 
 // OverrideMessage overrides the validation error message of current validator
-func (v *IntGreaterThanOrEqualTo) OverrideMessage(msg string) *IntGreaterThanOrEqualTo {
+func (v *GreaterThanOrEqualTo[T]) OverrideMessage(msg string) *GreaterThanOrEqualTo[T] {
 	v.message = &msg
 	return v
 }
 
-// IntEqualTo is a validator which will check whether the field Value equals to field Target.
-type IntEqualTo struct {
-	Value  int
-	Target int
+// IntGreaterThanOrEqualTo is a validator which will check whether the int field Value is greater than or equal to field Target.
+type IntGreaterThanOrEqualTo = GreaterThanOrEqualTo[int]
+
+// EqualTo is a validator which will check whether the field Value is equal to field Target.
+//
+// A NaN Value or Target is always invalid.
+type EqualTo[T Number] struct {
+	Value  T
+	Target T
 
 	message *string
 }
 
 // Validate implements the guard.Validator interface
-func (v *IntEqualTo) Validate() error {
-	if v.Value != v.Target {
-		return &validationError{returnDefaultStringIfNil(v.message, intEqualToMsg)}
+func (v *EqualTo[T]) Validate() error {
+	if !(v.Value == v.Target) {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, equalToMsg),
+			overridden: v.message != nil,
+			code:       CodeNotEqualTo,
+			params:     map[string]interface{}{"target": v.Target},
+		}
 	}
 	return nil
 }
 
 // OverrideMessage overrides the validation error message of current validator
-func (v *IntEqualTo) OverrideMessage(msg string) *IntEqualTo {
+func (v *EqualTo[T]) OverrideMessage(msg string) *EqualTo[T] {
 	v.message = &msg
 	return v
 }
 
-// IntLessThan is a validator which will check whether the field Value is less than field Target.
-type IntLessThan struct {
-	Value  int
-	Target int
+// IntEqualTo is a validator which will check whether the int field Value is equal to field Target.
+type IntEqualTo = EqualTo[int]
+
+// LessThan is a validator which will check whether the field Value is less than field Target.
+//
+// A NaN Value or Target is always invalid.
+type LessThan[T Number] struct {
+	Value  T
+	Target T
 
 	message *string
 }
 
 // Validate implements the guard.Validator interface
-func (v *IntLessThan) Validate() error {
-	if v.Value >= v.Target {
-		return &validationError{returnDefaultStringIfNil(v.message, intLessThanMsg)}
+func (v *LessThan[T]) Validate() error {
+	if !(v.Value < v.Target) {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, lessThanMsg),
+			overridden: v.message != nil,
+			code:       CodeNotLessThan,
+			params:     map[string]interface{}{"target": v.Target},
+		}
 	}
 	return nil
 }
 
 // OverrideMessage overrides the validation error message of current validator
-func (v *IntLessThan) OverrideMessage(msg string) *IntLessThan {
+func (v *LessThan[T]) OverrideMessage(msg string) *LessThan[T] {
 	v.message = &msg
 	return v
 }
 
-// IntLessThanOrEqualTo is a validator which will check whether the field Value is less than or equal to field Target.
-type IntLessThanOrEqualTo struct {
-	Value  int
-	Target int
+// IntLessThan is a validator which will check whether the int field Value is less than field Target.
+type IntLessThan = LessThan[int]
+
+// LessThanOrEqualTo is a validator which will check whether the field Value is less than or equal to field Target.
+//
+// A NaN Value or Target is always invalid.
+type LessThanOrEqualTo[T Number] struct {
+	Value  T
+	Target T
 
 	message *string
 }
 
 // Validate implements the guard.Validator interface
-func (v *IntLessThanOrEqualTo) Validate() error {
-	if v.Value > v.Target {
-		return &validationError{returnDefaultStringIfNil(v.message, intLessThanOrEqualToMsg)}
+func (v *LessThanOrEqualTo[T]) Validate() error {
+	if !(v.Value <= v.Target) {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, lessThanOrEqualToMsg),
+			overridden: v.message != nil,
+			code:       CodeNotLessThanOrEqualTo,
+			params:     map[string]interface{}{"target": v.Target},
+		}
 	}
 	return nil
 }
 
 // OverrideMessage overrides the validation error message of current validator
-func (v *IntLessThanOrEqualTo) OverrideMessage(msg string) *IntLessThanOrEqualTo {
+func (v *LessThanOrEqualTo[T]) OverrideMessage(msg string) *LessThanOrEqualTo[T] {
 	v.message = &msg
 	return v
 }
 
-// IntInRange is a validator which will check whether the field Value is in range of field Left and Right.
-type IntInRange struct {
-	Value int
-	Left  int
-	Right int
+// IntLessThanOrEqualTo is a validator which will check whether the int field Value is less than or equal to field Target.
+type IntLessThanOrEqualTo = LessThanOrEqualTo[int]
+
+// InRange is a validator which will check whether the field Value is in range of field Left and Right.
+//
+// A NaN Value is always invalid.
+type InRange[T Number] struct {
+	Value T
+	Left  T
+	Right T
 
 	leftMessage  *string
 	rightMessage *string
 }
 
 // Validate implements the guard.Validator interface
-func (v *IntInRange) Validate() error {
-	if v.Value < v.Left {
-		return &validationError{returnDefaultStringIfNil(v.leftMessage, intInRangeLeftMsg)}
+func (v *InRange[T]) Validate() error {
+	if !(v.Value >= v.Left) {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.leftMessage, inRangeLeftMsg),
+			overridden: v.leftMessage != nil,
+			code:       CodeOutOfLeftRange,
+			params:     map[string]interface{}{"left": v.Left, "right": v.Right},
+		}
 	}
-	if v.Value > v.Right {
-		return &validationError{returnDefaultStringIfNil(v.rightMessage, intInRangeRightMsg)}
+	if !(v.Value <= v.Right) {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.rightMessage, inRangeRightMsg),
+			overridden: v.rightMessage != nil,
+			code:       CodeOutOfRightRange,
+			params:     map[string]interface{}{"left": v.Left, "right": v.Right},
+		}
 	}
 	return nil
 }
 
 // OverrideLeftMessage overrides the error message of the out of left range validation
-func (v *IntInRange) OverrideLeftMessage(msg string) *IntInRange {
+func (v *InRange[T]) OverrideLeftMessage(msg string) *InRange[T] {
 	v.leftMessage = &msg
 	return v
 }
 
 // OverrideRightMessage overrides the error message of the out of right range validation
-func (v *IntInRange) OverrideRightMessage(msg string) *IntInRange {
+func (v *InRange[T]) OverrideRightMessage(msg string) *InRange[T] {
 	v.rightMessage = &msg
 	return v
 }
+
+// IntInRange is a validator which will check whether the int field Value is in range of field Left and Right.
+type IntInRange = InRange[int]
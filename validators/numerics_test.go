@@ -1,6 +1,7 @@
 package validators_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/nauyey/guard/validators"
@@ -129,3 +130,77 @@ func TestIntInRange(t *testing.T) {
 		t.Errorf("validators.IntInRange faild")
 	}
 }
+
+func TestGreaterThan(t *testing.T) {
+	if err := (&validators.GreaterThan[int64]{Value: 1 << 40, Target: 1<<40 - 1}).Validate(); err != nil {
+		t.Errorf("validators.GreaterThan faild")
+	}
+	if err := (&validators.GreaterThan[uint32]{Value: 6, Target: 6}).Validate(); err == nil {
+		t.Errorf("validators.GreaterThan faild")
+	}
+	if err := (&validators.GreaterThan[float64]{Value: 6.5, Target: 6.4}).Validate(); err != nil {
+		t.Errorf("validators.GreaterThan faild")
+	}
+	if err := (&validators.GreaterThan[float64]{Value: math.NaN(), Target: 0}).Validate(); err == nil {
+		t.Errorf("validators.GreaterThan faild")
+	}
+
+	// test override error message
+	err := (&validators.GreaterThan[int8]{Value: 1, Target: 2}).OverrideMessage("override error message").Validate()
+	if err == nil || err.Error() != "override error message" {
+		t.Errorf("validators.GreaterThan faild")
+	}
+}
+
+func TestGreaterThanOrEqualTo(t *testing.T) {
+	if err := (&validators.GreaterThanOrEqualTo[int64]{Value: 6, Target: 6}).Validate(); err != nil {
+		t.Errorf("validators.GreaterThanOrEqualTo faild")
+	}
+	if err := (&validators.GreaterThanOrEqualTo[float32]{Value: 5.9, Target: 6}).Validate(); err == nil {
+		t.Errorf("validators.GreaterThanOrEqualTo faild")
+	}
+}
+
+func TestEqualTo(t *testing.T) {
+	if err := (&validators.EqualTo[uint64]{Value: 1 << 63, Target: 1 << 63}).Validate(); err != nil {
+		t.Errorf("validators.EqualTo faild")
+	}
+	if err := (&validators.EqualTo[float64]{Value: math.NaN(), Target: math.NaN()}).Validate(); err == nil {
+		t.Errorf("validators.EqualTo faild")
+	}
+}
+
+func TestLessThan(t *testing.T) {
+	if err := (&validators.LessThan[int16]{Value: -2, Target: -1}).Validate(); err != nil {
+		t.Errorf("validators.LessThan faild")
+	}
+	if err := (&validators.LessThan[float64]{Value: 1, Target: 1}).Validate(); err == nil {
+		t.Errorf("validators.LessThan faild")
+	}
+}
+
+func TestLessThanOrEqualTo(t *testing.T) {
+	if err := (&validators.LessThanOrEqualTo[uint]{Value: 1, Target: 1}).Validate(); err != nil {
+		t.Errorf("validators.LessThanOrEqualTo faild")
+	}
+	if err := (&validators.LessThanOrEqualTo[int32]{Value: 2, Target: 1}).Validate(); err == nil {
+		t.Errorf("validators.LessThanOrEqualTo faild")
+	}
+}
+
+func TestInRange(t *testing.T) {
+	type price float64
+
+	if err := (&validators.InRange[price]{Value: 9.99, Left: 0, Right: 10}).Validate(); err != nil {
+		t.Errorf("validators.InRange faild")
+	}
+	if err := (&validators.InRange[price]{Value: 10.01, Left: 0, Right: 10}).Validate(); err == nil {
+		t.Errorf("validators.InRange faild")
+	}
+	if err := (&validators.InRange[int64]{Value: -1, Left: 0, Right: 10}).Validate(); err == nil {
+		t.Errorf("validators.InRange faild")
+	}
+	if err := (&validators.InRange[float64]{Value: math.NaN(), Left: 0, Right: 10}).Validate(); err == nil {
+		t.Errorf("validators.InRange faild")
+	}
+}
@@ -0,0 +1,117 @@
+package validators
+
+import (
+	"regexp"
+	"sync"
+)
+
+// pattern validation error messages
+const (
+	stringMatchesMsg    = "should match the pattern"
+	stringNotMatchesMsg = "shouldn't match the pattern"
+)
+
+// pattern validation error codes
+const (
+	CodeStringNotMatched = "string.not_matched"
+	CodeStringMatched    = "string.matched"
+)
+
+// patterns caches the compiled regular expressions of pattern strings.
+var patterns sync.Map
+
+// compile returns the compiled regular expression of pattern, compiling it once.
+func compile(pattern string) (*regexp.Regexp, error) {
+	if re, ok := patterns.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	patterns.Store(pattern, re)
+	return re, nil
+}
+
+// regexpOf returns re if it isn't nil, otherwise the compiled regular expression of pattern.
+func regexpOf(re *regexp.Regexp, pattern string) (*regexp.Regexp, error) {
+	if re != nil {
+		return re, nil
+	}
+	return compile(pattern)
+}
+
+// StringMatches is a validator which will check whether the field Value matches a regular expression.
+//
+// The regular expression is field Regexp, or the compiled field Pattern if Regexp is nil.
+// Pattern strings are compiled once and cached across validations.
+// If Pattern is invalid, Validate returns the compile error, which isn't a validation error.
+type StringMatches struct {
+	Value   string
+	Pattern string
+	Regexp  *regexp.Regexp
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *StringMatches) Validate() error {
+	re, err := regexpOf(v.Regexp, v.Pattern)
+	if err != nil {
+		return err
+	}
+
+	if !re.MatchString(v.Value) {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, stringMatchesMsg),
+			overridden: v.message != nil,
+			code:       CodeStringNotMatched,
+			params:     map[string]interface{}{"pattern": re.String()},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *StringMatches) OverrideMessage(msg string) *StringMatches {
+	v.message = &msg
+	return v
+}
+
+// StringNotMatches is a validator which will check whether the field Value doesn't match a regular expression.
+//
+// The regular expression is field Regexp, or the compiled field Pattern if Regexp is nil.
+// Pattern strings are compiled once and cached across validations.
+// If Pattern is invalid, Validate returns the compile error, which isn't a validation error.
+type StringNotMatches struct {
+	Value   string
+	Pattern string
+	Regexp  *regexp.Regexp
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *StringNotMatches) Validate() error {
+	re, err := regexpOf(v.Regexp, v.Pattern)
+	if err != nil {
+		return err
+	}
+
+	if re.MatchString(v.Value) {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, stringNotMatchesMsg),
+			overridden: v.message != nil,
+			code:       CodeStringMatched,
+			params:     map[string]interface{}{"pattern": re.String()},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *StringNotMatches) OverrideMessage(msg string) *StringNotMatches {
+	v.message = &msg
+	return v
+}
@@ -0,0 +1,52 @@
+package validators_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/nauyey/guard"
+	"github.com/nauyey/guard/validators"
+)
+
+func TestStringMatches(t *testing.T) {
+	if err := (&validators.StringMatches{Value: "abc-123", Pattern: `^[a-z]+-\d+$`}).Validate(); err != nil {
+		t.Errorf("validators.StringMatches faild")
+	}
+	if err := (&validators.StringMatches{Value: "abc_123", Pattern: `^[a-z]+-\d+$`}).Validate(); err == nil {
+		t.Errorf("validators.StringMatches faild")
+	}
+	if err := (&validators.StringMatches{Value: "ABC", Regexp: regexp.MustCompile(`^[A-Z]+$`)}).Validate(); err != nil {
+		t.Errorf("validators.StringMatches faild")
+	}
+
+	// test error code and params
+	err := (&validators.StringMatches{Value: "abc", Pattern: `^\d+$`}).Validate()
+	cErr, ok := err.(guard.CodedError)
+	if !ok || cErr.Code() != validators.CodeStringNotMatched || cErr.Params()["pattern"] != `^\d+$` {
+		t.Errorf("validators.StringMatches faild")
+	}
+
+	// test invalid pattern
+	err = (&validators.StringMatches{Value: "abc", Pattern: `(`}).Validate()
+	if _, ok := err.(guard.Error); err == nil || ok {
+		t.Errorf("validators.StringMatches faild to return non-validation error")
+	}
+
+	// test override error message
+	err = (&validators.StringMatches{Value: "abc", Pattern: `^\d+$`}).OverrideMessage("override error message").Validate()
+	if err == nil || err.Error() != "override error message" {
+		t.Errorf("validators.StringMatches faild")
+	}
+}
+
+func TestStringNotMatches(t *testing.T) {
+	if err := (&validators.StringNotMatches{Value: "hello", Pattern: `(?i)admin`}).Validate(); err != nil {
+		t.Errorf("validators.StringNotMatches faild")
+	}
+	if err := (&validators.StringNotMatches{Value: "Admin", Pattern: `(?i)admin`}).Validate(); err == nil {
+		t.Errorf("validators.StringNotMatches faild")
+	}
+	if err := (&validators.StringNotMatches{Value: "abc", Pattern: `[`}).Validate(); err == nil {
+		t.Errorf("validators.StringNotMatches faild to return non-validation error")
+	}
+}
@@ -0,0 +1,138 @@
+package validators
+
+import (
+	"reflect"
+	"strings"
+)
+
+// presence validation error messages
+const (
+	notPresentMsg = "should be present"
+	notEmptyMsg   = "shouldn't be empty"
+	notZeroMsg    = "shouldn't be zero"
+)
+
+// presence validation error codes
+const (
+	CodeMissing = "value.missing"
+	CodeEmpty   = "value.empty"
+	CodeZero    = "value.zero"
+)
+
+// Present is a validator which will check whether the field Value is present,
+// which means it isn't nil, a blank string or a zero value.
+//
+// Nil is checked the same as NotNil, and blank the same as StringNotBlank.
+// A value with an IsZero method, like time.Time, is zero if IsZero returns true.
+type Present struct {
+	Value interface{}
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *Present) Validate() error {
+	if !present(v.Value) {
+		return &validationError{msg: returnDefaultStringIfNil(v.message, notPresentMsg), overridden: v.message != nil, code: CodeMissing}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *Present) OverrideMessage(msg string) *Present {
+	v.message = &msg
+	return v
+}
+
+// NotEmpty is a validator which will check whether the field Value isn't nil or empty.
+//
+// A string, slice, map, array or channel is empty if its length is 0.
+// The values of the other kinds are only checked whether they are nil.
+type NotEmpty struct {
+	Value interface{}
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *NotEmpty) Validate() error {
+	rv := reflect.ValueOf(v.Value)
+	if isNil(rv) || hasLen(rv) && rv.Len() == 0 {
+		return &validationError{msg: returnDefaultStringIfNil(v.message, notEmptyMsg), overridden: v.message != nil, code: CodeEmpty}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *NotEmpty) OverrideMessage(msg string) *NotEmpty {
+	v.message = &msg
+	return v
+}
+
+// NotZero is a validator which will check whether the field Value isn't nil or the zero value of its type.
+//
+// A value with an IsZero method, like time.Time, is zero if IsZero returns true.
+type NotZero struct {
+	Value interface{}
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *NotZero) Validate() error {
+	if isZeroValue(v.Value) {
+		return &validationError{msg: returnDefaultStringIfNil(v.message, notZeroMsg), overridden: v.message != nil, code: CodeZero}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *NotZero) OverrideMessage(msg string) *NotZero {
+	v.message = &msg
+	return v
+}
+
+// present reports whether value is present, which means it isn't nil, a blank string or a zero value.
+func present(value interface{}) bool {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.String {
+		return strings.IndexFunc(rv.String(), func(r rune) bool { return !isBlankRune(r) }) >= 0
+	}
+	return !isZeroValue(value)
+}
+
+// isZeroValue reports whether value is nil or the zero value of its type,
+// or its IsZero method returns true.
+func isZeroValue(value interface{}) bool {
+	rv := reflect.ValueOf(value)
+	if isNil(rv) {
+		return true
+	}
+	if z, ok := value.(interface{ IsZero() bool }); ok {
+		return z.IsZero()
+	}
+	return rv.IsZero()
+}
+
+// isNil reports whether rv is invalid, which is the value of a nil interface, or a nil value of
+// a nilable kind: chan, func, interface, map, pointer, slice or unsafe pointer.
+//
+// A typed nil in an interface, like (*T)(nil), is nil, too.
+func isNil(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice, reflect.UnsafePointer:
+		return rv.IsNil()
+	}
+	return false
+}
+
+// hasLen reports whether the values of the kind of rv have lengths.
+func hasLen(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array, reflect.Chan:
+		return true
+	}
+	return false
+}
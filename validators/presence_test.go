@@ -0,0 +1,80 @@
+package validators_test
+
+import (
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/nauyey/guard/validators"
+)
+
+// nilValues are typed nils of every nilable kind.
+var nilValues = []interface{}{
+	nil,
+	(*int)(nil),
+	[]string(nil),
+	map[string]string(nil),
+	(chan int)(nil),
+	(func())(nil),
+	unsafe.Pointer(nil),
+}
+
+func TestPresent(t *testing.T) {
+	for _, value := range nilValues {
+		if err := (&validators.Present{Value: value}).Validate(); codeOf(err) != validators.CodeMissing {
+			t.Errorf("validators.Present faild with value=%#v, err=%v", value, err)
+		}
+	}
+	for _, value := range []interface{}{"", "  ​", 0, false, time.Time{}, struct{}{}} {
+		if err := (&validators.Present{Value: value}).Validate(); err == nil {
+			t.Errorf("validators.Present faild with value=%#v", value)
+		}
+	}
+	for _, value := range []interface{}{"abc", 1, true, time.Now(), []string{}, map[string]string{}, new(int)} {
+		if err := (&validators.Present{Value: value}).Validate(); err != nil {
+			t.Errorf("validators.Present faild with value=%#v, err=%v", value, err)
+		}
+	}
+}
+
+func TestNotEmpty(t *testing.T) {
+	for _, value := range nilValues {
+		if err := (&validators.NotEmpty{Value: value}).Validate(); codeOf(err) != validators.CodeEmpty {
+			t.Errorf("validators.NotEmpty faild with value=%#v, err=%v", value, err)
+		}
+	}
+	for _, value := range []interface{}{"", []string{}, map[string]string{}, [0]int{}, make(chan int)} {
+		if err := (&validators.NotEmpty{Value: value}).Validate(); err == nil {
+			t.Errorf("validators.NotEmpty faild with value=%#v", value)
+		}
+	}
+	for _, value := range []interface{}{" ", []string{""}, map[string]string{"a": ""}, [1]int{}, 0, new(int)} {
+		if err := (&validators.NotEmpty{Value: value}).Validate(); err != nil {
+			t.Errorf("validators.NotEmpty faild with value=%#v, err=%v", value, err)
+		}
+	}
+
+	// test override error message
+	err := (&validators.NotEmpty{Value: ""}).OverrideMessage("override error message").Validate()
+	if err == nil || err.Error() != "override error message" {
+		t.Errorf("validators.NotEmpty faild")
+	}
+}
+
+func TestNotZero(t *testing.T) {
+	for _, value := range nilValues {
+		if err := (&validators.NotZero{Value: value}).Validate(); codeOf(err) != validators.CodeZero {
+			t.Errorf("validators.NotZero faild with value=%#v, err=%v", value, err)
+		}
+	}
+	for _, value := range []interface{}{"", 0, 0.0, false, time.Time{}, struct{ A int }{}} {
+		if err := (&validators.NotZero{Value: value}).Validate(); err == nil {
+			t.Errorf("validators.NotZero faild with value=%#v", value)
+		}
+	}
+	for _, value := range []interface{}{" ", -1, true, time.Now(), struct{ A int }{1}, []string{}} {
+		if err := (&validators.NotZero{Value: value}).Validate(); err != nil {
+			t.Errorf("validators.NotZero faild with value=%#v, err=%v", value, err)
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/nauyey/guard"
+)
+
+// slice validation error messages
+const (
+	tooFewItemsMsg  = "too few items"
+	tooManyItemsMsg = "too many items"
+	notUniqueMsg    = "items should be unique"
+	missingItemsMsg = "should contain all of"
+	containsNoneMsg = "should contain any of"
+	notSubsetMsg    = "items should be in"
+)
+
+// slice validation error codes
+const (
+	CodeSliceTooFew       = "slice.too_few"
+	CodeSliceTooMany      = "slice.too_many"
+	CodeSliceNotUnique    = "slice.not_unique"
+	CodeSliceMissing      = "slice.missing"
+	CodeSliceContainsNone = "slice.contains_none"
+	CodeSliceNotSubset    = "slice.not_subset"
+)
+
+// Each is a validator which will validate every element of field Values by the validator
+// returned by field Validator.
+//
+// The validation errors of an element are bound to its index, like "[3]", so
+// guard.Field("items", &validators.Each[*Item]{...}) reports errors like "items[3].price".
+// If Validator is nil or returns nil for an element, the element is valid.
+type Each[T any] struct {
+	Values    []T
+	Validator func(value T) guard.Validator
+}
+
+// Validate implements the guard.Validator interface
+func (v *Each[T]) Validate() error {
+	return v.ValidateContext(context.Background())
+}
+
+// ValidateContext implements the guard.ContextValidator interface
+func (v *Each[T]) ValidateContext(ctx context.Context) error {
+	if v.Validator == nil {
+		return nil
+	}
+
+	vs := make([]guard.Validator, 0, len(v.Values))
+	for i, value := range v.Values {
+		if ev := v.Validator(value); ev != nil {
+			vs = append(vs, guard.Index(i, ev))
+		}
+	}
+	return guard.ValidateContext(ctx, vs...)
+}
+
+// MinItems is a validator which will check whether field Values has at least field Min elements.
+type MinItems[T any] struct {
+	Values []T
+	Min    int
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *MinItems[T]) Validate() error {
+	if len(v.Values) < v.Min {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, tooFewItemsMsg),
+			overridden: v.message != nil,
+			code:       CodeSliceTooFew,
+			params:     map[string]interface{}{"min": v.Min},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *MinItems[T]) OverrideMessage(msg string) *MinItems[T] {
+	v.message = &msg
+	return v
+}
+
+// MaxItems is a validator which will check whether field Values has at most field Max elements.
+type MaxItems[T any] struct {
+	Values []T
+	Max    int
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *MaxItems[T]) Validate() error {
+	if len(v.Values) > v.Max {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, tooManyItemsMsg),
+			overridden: v.message != nil,
+			code:       CodeSliceTooMany,
+			params:     map[string]interface{}{"max": v.Max},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *MaxItems[T]) OverrideMessage(msg string) *MaxItems[T] {
+	v.message = &msg
+	return v
+}
+
+// Unique is a validator which will check whether the elements of field Values are unique.
+type Unique[T comparable] struct {
+	Values []T
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *Unique[T]) Validate() error {
+	return validateUnique(v.Values, func(value T) T { return value }, v.message)
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *Unique[T]) OverrideMessage(msg string) *Unique[T] {
+	v.message = &msg
+	return v
+}
+
+// UniqueBy is a validator which will check whether the keys of the elements of field Values are unique.
+// The key of an element is returned by field Key.
+type UniqueBy[T any, K comparable] struct {
+	Values []T
+	Key    func(value T) K
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *UniqueBy[T, K]) Validate() error {
+	return validateUnique(v.Values, v.Key, v.message)
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *UniqueBy[T, K]) OverrideMessage(msg string) *UniqueBy[T, K] {
+	v.message = &msg
+	return v
+}
+
+func validateUnique[T any, K comparable](values []T, key func(T) K, message *string) error {
+	seen := make(map[K]int, len(values))
+	for i, value := range values {
+		k := key(value)
+		if first, ok := seen[k]; ok {
+			return &validationError{
+				msg:        returnDefaultStringIfNil(message, notUniqueMsg),
+				overridden: message != nil,
+				code:       CodeSliceNotUnique,
+				params:     map[string]interface{}{"index": i, "first_index": first},
+			}
+		}
+		seen[k] = i
+	}
+	return nil
+}
+
+// ContainsAll is a validator which will check whether field Values contains all of field Targets.
+type ContainsAll[T comparable] struct {
+	Values  []T
+	Targets []T
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *ContainsAll[T]) Validate() error {
+	if missing := difference(v.Targets, v.Values); len(missing) != 0 {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, missingItemsMsg),
+			overridden: v.message != nil,
+			code:       CodeSliceMissing,
+			params:     map[string]interface{}{"targets": v.Targets, "missing": missing},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *ContainsAll[T]) OverrideMessage(msg string) *ContainsAll[T] {
+	v.message = &msg
+	return v
+}
+
+// ContainsAny is a validator which will check whether field Values contains any of field Targets.
+type ContainsAny[T comparable] struct {
+	Values  []T
+	Targets []T
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *ContainsAny[T]) Validate() error {
+	if len(difference(v.Targets, v.Values)) == len(v.Targets) {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, containsNoneMsg),
+			overridden: v.message != nil,
+			code:       CodeSliceContainsNone,
+			params:     map[string]interface{}{"targets": v.Targets},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *ContainsAny[T]) OverrideMessage(msg string) *ContainsAny[T] {
+	v.message = &msg
+	return v
+}
+
+// SubsetOf is a validator which will check whether every element of field Values is in field Allowed.
+type SubsetOf[T comparable] struct {
+	Values  []T
+	Allowed []T
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *SubsetOf[T]) Validate() error {
+	if invalid := difference(v.Values, v.Allowed); len(invalid) != 0 {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, notSubsetMsg),
+			overridden: v.message != nil,
+			code:       CodeSliceNotSubset,
+			params:     map[string]interface{}{"allowed": v.Allowed, "invalid": invalid},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *SubsetOf[T]) OverrideMessage(msg string) *SubsetOf[T] {
+	v.message = &msg
+	return v
+}
+
+// difference returns the elements of a which aren't in b, in the order of a.
+func difference[T comparable](a, b []T) []T {
+	set := make(map[T]struct{}, len(b))
+	for _, value := range b {
+		set[value] = struct{}{}
+	}
+
+	diff := []T{}
+	for _, value := range a {
+		if _, ok := set[value]; !ok {
+			diff = append(diff, value)
+		}
+	}
+	return diff
+}
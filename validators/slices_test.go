@@ -0,0 +1,148 @@
+package validators_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nauyey/guard"
+	"github.com/nauyey/guard/validators"
+)
+
+func TestEach(t *testing.T) {
+	tags := []string{"go", " ", "validation", ""}
+	each := &validators.Each[string]{
+		Values: tags,
+		Validator: func(tag string) guard.Validator {
+			return &validators.StringNotBlank{Value: tag}
+		},
+	}
+
+	err := guard.Validate(guard.Field("tags", each))
+	errs, ok := err.(guard.Errors)
+	if !ok {
+		t.Fatalf("validators.Each faild to return err(type guard.Errors)")
+	}
+	vErrs := errs.ValidationErrors()
+	want := []string{"tags[1]", "tags[3]"}
+	if len(vErrs) != len(want) {
+		t.Fatalf("validators.Each faild with len(vErrs)=%d, want len(vErrs)=%d", len(vErrs), len(want))
+	}
+	for i, path := range want {
+		if fErr, ok := vErrs[i].(guard.FieldError); !ok || fErr.Field() != path {
+			t.Errorf("validators.Each faild with err=%v, want field=%q", vErrs[i], path)
+		}
+	}
+
+	// test without validation errors and with nil validators
+	err = (&validators.Each[string]{
+		Values: tags,
+		Validator: func(tag string) guard.Validator {
+			if strings.TrimSpace(tag) == "" {
+				return nil
+			}
+			return &validators.StringLength{Value: tag, Min: 1, Max: 10}
+		},
+	}).Validate()
+	if err != nil {
+		t.Errorf("validators.Each faild with err=%v", err)
+	}
+
+	// test without Validator
+	if err := (&validators.Each[int]{Values: []int{1}}).Validate(); err != nil {
+		t.Errorf("validators.Each faild with err=%v", err)
+	}
+}
+
+func TestMinItems(t *testing.T) {
+	if err := (&validators.MinItems[int]{Values: []int{1, 2}, Min: 2}).Validate(); err != nil {
+		t.Errorf("validators.MinItems faild")
+	}
+	if err := (&validators.MinItems[int]{Values: nil, Min: 1}).Validate(); err == nil {
+		t.Errorf("validators.MinItems faild")
+	}
+}
+
+func TestMaxItems(t *testing.T) {
+	if err := (&validators.MaxItems[int]{Values: []int{1, 2}, Max: 2}).Validate(); err != nil {
+		t.Errorf("validators.MaxItems faild")
+	}
+	if err := (&validators.MaxItems[int]{Values: []int{1, 2, 3}, Max: 2}).Validate(); err == nil {
+		t.Errorf("validators.MaxItems faild")
+	}
+}
+
+func TestUnique(t *testing.T) {
+	if err := (&validators.Unique[string]{Values: []string{"a", "b", "c"}}).Validate(); err != nil {
+		t.Errorf("validators.Unique faild")
+	}
+	err := (&validators.Unique[string]{Values: []string{"a", "b", "a"}}).Validate()
+	cErr, ok := err.(guard.CodedError)
+	if !ok || cErr.Code() != validators.CodeSliceNotUnique || cErr.Params()["index"] != 2 || cErr.Params()["first_index"] != 0 {
+		t.Errorf("validators.Unique faild with err=%v", err)
+	}
+}
+
+func TestUniqueBy(t *testing.T) {
+	type user struct {
+		ID    int
+		Email string
+	}
+	users := []user{{1, "a@example.com"}, {2, "A@example.com"}}
+
+	if err := (&validators.UniqueBy[user, int]{Values: users, Key: func(u user) int { return u.ID }}).Validate(); err != nil {
+		t.Errorf("validators.UniqueBy faild")
+	}
+	err := (&validators.UniqueBy[user, string]{
+		Values: users,
+		Key:    func(u user) string { return strings.ToLower(u.Email) },
+	}).Validate()
+	if err == nil {
+		t.Errorf("validators.UniqueBy faild")
+	}
+}
+
+func TestContainsAll(t *testing.T) {
+	if err := (&validators.ContainsAll[string]{Values: []string{"a", "b", "c"}, Targets: []string{"c", "a"}}).Validate(); err != nil {
+		t.Errorf("validators.ContainsAll faild")
+	}
+	err := (&validators.ContainsAll[string]{Values: []string{"a"}, Targets: []string{"a", "b", "c"}}).Validate()
+	cErr, ok := err.(guard.CodedError)
+	if !ok || !reflect.DeepEqual(cErr.Params()["missing"], []string{"b", "c"}) {
+		t.Errorf("validators.ContainsAll faild with err=%v", err)
+	}
+}
+
+func TestContainsAny(t *testing.T) {
+	if err := (&validators.ContainsAny[int]{Values: []int{1, 2}, Targets: []int{2, 3}}).Validate(); err != nil {
+		t.Errorf("validators.ContainsAny faild")
+	}
+	if err := (&validators.ContainsAny[int]{Values: []int{1, 2}, Targets: []int{3, 4}}).Validate(); err == nil {
+		t.Errorf("validators.ContainsAny faild")
+	}
+	if err := (&validators.ContainsAny[int]{Values: []int{1, 2}}).Validate(); err == nil {
+		t.Errorf("validators.ContainsAny faild")
+	}
+}
+
+func TestSubsetOf(t *testing.T) {
+	allowed := []string{"red", "green", "blue"}
+
+	if err := (&validators.SubsetOf[string]{Values: []string{"red", "blue"}, Allowed: allowed}).Validate(); err != nil {
+		t.Errorf("validators.SubsetOf faild")
+	}
+	if err := (&validators.SubsetOf[string]{Values: nil, Allowed: allowed}).Validate(); err != nil {
+		t.Errorf("validators.SubsetOf faild")
+	}
+	err := (&validators.SubsetOf[string]{Values: []string{"red", "pink"}, Allowed: allowed}).Validate()
+	cErr, ok := err.(guard.CodedError)
+	if !ok || !reflect.DeepEqual(cErr.Params()["invalid"], []string{"pink"}) {
+		t.Errorf("validators.SubsetOf faild with err=%v", err)
+	}
+
+	// test override error message
+	err = (&validators.SubsetOf[string]{Values: []string{"pink"}, Allowed: allowed}).OverrideMessage("override error message").Validate()
+	if err == nil || err.Error() != "override error message" {
+		t.Errorf("validators.SubsetOf faild")
+	}
+}
@@ -1,17 +1,9 @@
 package validators
 
-import "regexp"
-
-const blank = `\A[[:space:]]*\z`
-
-var blankReg *regexp.Regexp
-
-func init() {
-	var err error
-	if blankReg, err = regexp.Compile(blank); err != nil {
-		panic(err)
-	}
-}
+import (
+	"strings"
+	"unicode/utf8"
+)
 
 // string validation error messages
 const (
@@ -22,14 +14,25 @@ const (
 	tooLongMsg         = "too long"
 )
 
+// string validation error codes
+const (
+	CodeStringBlank       = "string.blank"
+	CodeStringNotIncluded = "string.not_included"
+	CodeStringExcluded    = "string.excluded"
+	CodeStringTooShort    = "string.too_short"
+	CodeStringTooLong     = "string.too_long"
+)
+
 // StringNotBlank is a validator which will check whether the field Value is not blank.
 //
-// A string is blank if it's empty or contains whitespaces only:
-// ""       -> blank
-// "  "     -> blank
-// "	"   -> blank
-// "\t\n\r" -> blank
-// " abc "  -> not blank
+// A string is blank if it's empty or contains Unicode whitespaces and zero-width characters only:
+// ""             -> blank
+// "  "           -> blank
+// "	"         -> blank
+// "\t\n\r"       -> blank
+// "\u00a0\u3000" -> blank
+// "\u200b"       -> blank
+// " abc "        -> not blank
 //
 type StringNotBlank struct {
 	Value string
@@ -39,8 +42,8 @@ type StringNotBlank struct {
 
 // Validate implements the guard.Validator interface
 func (v *StringNotBlank) Validate() error {
-	if len(v.Value) == 0 || blankReg.Match([]byte(v.Value)) {
-		return &validationError{returnDefaultStringIfNil(v.message, stringNotBlankMsg)}
+	if strings.IndexFunc(v.Value, func(r rune) bool { return !isBlankRune(r) }) < 0 {
+		return &validationError{msg: returnDefaultStringIfNil(v.message, stringNotBlankMsg), overridden: v.message != nil, code: CodeStringBlank}
 	}
 
 	return nil
@@ -63,7 +66,12 @@ type StringInclusion struct {
 // Validate implements the guard.Validator interface
 func (v *StringInclusion) Validate() error {
 	if !contains(v.In, v.Value) {
-		return &validationError{returnDefaultStringIfNil(v.message, stringInclusionMsg)}
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, stringInclusionMsg),
+			overridden: v.message != nil,
+			code:       CodeStringNotIncluded,
+			params:     map[string]interface{}{"in": v.In},
+		}
 	}
 
 	return nil
@@ -86,7 +94,12 @@ type StringExclusion struct {
 // Validate implements the guard.Validator interface
 func (v *StringExclusion) Validate() error {
 	if contains(v.In, v.Value) {
-		return &validationError{returnDefaultStringIfNil(v.message, stringExclusionMsg)}
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, stringExclusionMsg),
+			overridden: v.message != nil,
+			code:       CodeStringExcluded,
+			params:     map[string]interface{}{"in": v.In},
+		}
 	}
 
 	return nil
@@ -98,11 +111,43 @@ func (v *StringExclusion) OverrideMessage(msg string) *StringExclusion {
 	return v
 }
 
+// LengthMode is the way to count the length of a string.
+type LengthMode int
+
+// string length modes
+const (
+	// LengthBytes counts the bytes of a string. "你好" is length 6.
+	LengthBytes LengthMode = iota
+	// LengthRunes counts the Unicode code points of a string. "你好" is length 2.
+	LengthRunes
+	// LengthGraphemes counts the user-perceived characters (grapheme clusters) of a string.
+	// "e\u0301" and "👍🏽" are both length 1.
+	LengthGraphemes
+	// LengthDisplayWidth counts the columns of a string in a monospace font.
+	// East Asian wide characters and emojis are 2 columns, so "你好" is length 4.
+	LengthDisplayWidth
+)
+
+func (mode LengthMode) length(s string) int {
+	switch mode {
+	case LengthRunes:
+		return utf8.RuneCountInString(s)
+	case LengthGraphemes:
+		return graphemes(s)
+	case LengthDisplayWidth:
+		return displayWidth(s)
+	}
+	return len(s)
+}
+
 // StringLength is a validator which will check whether the length of field Value is in range of filed Min and Max.
+//
+// The length is counted by field Mode, which is LengthBytes by default.
 type StringLength struct {
 	Value string
 	Min   int
 	Max   int
+	Mode  LengthMode
 
 	tooShortMessage *string
 	tooLongMessage  *string
@@ -110,12 +155,24 @@ type StringLength struct {
 
 // Validate implements the guard.Validator interface
 func (v *StringLength) Validate() error {
-	if len(v.Value) < v.Min {
-		return &validationError{returnDefaultStringIfNil(v.tooShortMessage, tooShortMsg)}
+	length := v.Mode.length(v.Value)
+
+	if length < v.Min {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.tooShortMessage, tooShortMsg),
+			overridden: v.tooShortMessage != nil,
+			code:       CodeStringTooShort,
+			params:     map[string]interface{}{"min": v.Min, "max": v.Max},
+		}
 	}
 
-	if len(v.Value) > v.Max {
-		return &validationError{returnDefaultStringIfNil(v.tooLongMessage, tooLongMsg)}
+	if length > v.Max {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.tooLongMessage, tooLongMsg),
+			overridden: v.tooLongMessage != nil,
+			code:       CodeStringTooLong,
+			params:     map[string]interface{}{"min": v.Min, "max": v.Max},
+		}
 	}
 
 	return nil
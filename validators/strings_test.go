@@ -19,6 +19,15 @@ func TestStringNotBlank(t *testing.T) {
 	if err := (&validators.StringNotBlank{Value: " 	\t\n"}).Validate(); err == nil {
 		t.Errorf("validators.StringNotBlank faild")
 	}
+	if err := (&validators.StringNotBlank{Value: "\u00a0\u3000\u2003"}).Validate(); err == nil {
+		t.Errorf("validators.StringNotBlank faild")
+	}
+	if err := (&validators.StringNotBlank{Value: "\u200b\ufeff "}).Validate(); err == nil {
+		t.Errorf("validators.StringNotBlank faild")
+	}
+	if err := (&validators.StringNotBlank{Value: "\u3000你好\u3000"}).Validate(); err != nil {
+		t.Errorf("validators.StringNotBlank faild")
+	}
 
 	// test override error message
 	err := (&validators.StringNotBlank{Value: ""}).Validate()
@@ -102,3 +111,39 @@ func TestStringLength(t *testing.T) {
 		t.Errorf("validators.StringLength faild")
 	}
 }
+
+func TestStringLengthModes(t *testing.T) {
+	tests := []struct {
+		value string
+		mode  validators.LengthMode
+		want  int
+	}{
+		{"你好", validators.LengthBytes, 6},
+		{"你好", validators.LengthRunes, 2},
+		{"你好", validators.LengthGraphemes, 2},
+		{"你好", validators.LengthDisplayWidth, 4},
+		{"e\u0301", validators.LengthRunes, 2},
+		{"e\u0301", validators.LengthGraphemes, 1},
+		{"e\u0301", validators.LengthDisplayWidth, 1},
+		{"\r\n", validators.LengthGraphemes, 1},
+		{"\U0001F44D\U0001F3FD", validators.LengthGraphemes, 1},
+		{"\U0001F468\u200D\U0001F469\u200D\U0001F467", validators.LengthGraphemes, 1},
+		{"\U0001F468\u200D\U0001F469\u200D\U0001F467", validators.LengthDisplayWidth, 2},
+		{"\U0001F1E8\U0001F1F3\U0001F1E9\U0001F1EA", validators.LengthGraphemes, 2},
+		{"\u1100\u1161\u11A8", validators.LengthGraphemes, 1},
+		{"한국어", validators.LengthGraphemes, 3},
+		{"ｈｉ!", validators.LengthDisplayWidth, 5},
+		{"a\u200bb", validators.LengthDisplayWidth, 2},
+	}
+
+	for _, test := range tests {
+		v := &validators.StringLength{Value: test.value, Min: test.want, Max: test.want, Mode: test.mode}
+		if err := v.Validate(); err != nil {
+			t.Errorf("validators.StringLength faild with value=%q, mode=%d", test.value, test.mode)
+		}
+		v = &validators.StringLength{Value: test.value, Min: test.want + 1, Max: test.want + 1, Mode: test.mode}
+		if err := v.Validate(); err == nil {
+			t.Errorf("validators.StringLength faild with value=%q, mode=%d", test.value, test.mode)
+		}
+	}
+}
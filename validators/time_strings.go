@@ -0,0 +1,86 @@
+package validators
+
+import (
+	"context"
+	"time"
+
+	"github.com/nauyey/guard"
+)
+
+// time string validation error messages
+const (
+	timeMalformedMsg = "should be a time"
+)
+
+// time string validation error codes
+const (
+	CodeTimeMalformed = "time.malformed"
+)
+
+// TimeString is a validator which will check whether the field Value is a time in one of field Layouts.
+//
+// The layouts are tried in order, and Layouts is []string{time.RFC3339} if it's empty.
+// Times without time zones are parsed in field Location, or UTC if Location is nil.
+//
+// If field Then isn't nil and Value is parsed, the validator returned by Then with the parsed time
+// is executed in the same validation, like:
+//
+//	&validators.TimeString{
+//		Value:   req.StartDate,
+//		Layouts: []string{"2006-01-02"},
+//		Then: func(t time.Time) guard.Validator {
+//			return &validators.TimeFromNow{Value: t, Min: 0, Max: 30 * 24 * time.Hour}
+//		},
+//	}
+type TimeString struct {
+	Value    string
+	Layouts  []string
+	Location *time.Location
+	Then     func(t time.Time) guard.Validator
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *TimeString) Validate() error {
+	return v.ValidateContext(context.Background())
+}
+
+// ValidateContext implements the guard.ContextValidator interface
+func (v *TimeString) ValidateContext(ctx context.Context) error {
+	layouts := v.Layouts
+	if len(layouts) == 0 {
+		layouts = []string{time.RFC3339}
+	}
+	loc := v.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	for _, layout := range layouts {
+		t, err := time.ParseInLocation(layout, v.Value, loc)
+		if err != nil {
+			continue
+		}
+		if v.Then == nil {
+			return nil
+		}
+		if then := v.Then(t); then != nil {
+			return guard.ValidateContext(ctx, then)
+		}
+		return nil
+	}
+
+	return &validationError{
+		msg:        returnDefaultStringIfNil(v.message, timeMalformedMsg),
+		overridden: v.message != nil,
+		code:       CodeTimeMalformed,
+		params:     map[string]interface{}{"layouts": layouts},
+	}
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *TimeString) OverrideMessage(msg string) *TimeString {
+	v.message = &msg
+	return v
+}
@@ -0,0 +1,56 @@
+package validators_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nauyey/guard"
+	"github.com/nauyey/guard/validators"
+)
+
+func TestTimeString(t *testing.T) {
+	if err := (&validators.TimeString{Value: "2020-01-01T12:00:00+08:00"}).Validate(); err != nil {
+		t.Errorf("validators.TimeString faild")
+	}
+	if err := (&validators.TimeString{Value: "2020-01-01"}).Validate(); err == nil {
+		t.Errorf("validators.TimeString faild")
+	}
+	if err := (&validators.TimeString{Value: "2020-01-01", Layouts: []string{time.RFC3339, "2006-01-02"}}).Validate(); err != nil {
+		t.Errorf("validators.TimeString faild")
+	}
+	if code := codeOf((&validators.TimeString{Value: "2020-13-01", Layouts: []string{"2006-01-02"}}).Validate()); code != validators.CodeTimeMalformed {
+		t.Errorf("validators.TimeString faild with code=%q", code)
+	}
+
+	// test Location and Then
+	loc := time.FixedZone("UTC+8", 8*60*60)
+	var parsed time.Time
+	err := (&validators.TimeString{
+		Value:    "2020-01-01 12:00",
+		Layouts:  []string{"2006-01-02 15:04"},
+		Location: loc,
+		Then: func(t time.Time) guard.Validator {
+			parsed = t
+			return &validators.TimeFromNow{Value: t, Min: 0, Max: time.Hour, Clock: testClock}
+		},
+	}).Validate()
+	if !parsed.Equal(time.Date(2020, 1, 1, 4, 0, 0, 0, time.UTC)) {
+		t.Errorf("validators.TimeString faild with parsed=%v", parsed)
+	}
+	errs, ok := err.(guard.Errors)
+	if !ok || len(errs.ValidationErrors()) != 1 || codeOf(errs.ValidationErrors()[0]) != validators.CodeTimeTooEarly {
+		t.Errorf("validators.TimeString faild with err=%v", err)
+	}
+
+	// test Then isn't executed with malformed value
+	err = (&validators.TimeString{
+		Value: "abc",
+		Then: func(time.Time) guard.Validator {
+			t.Errorf("validators.TimeString faild to skip Then")
+			return nil
+		},
+	}).Validate()
+	if code := codeOf(err); code != validators.CodeTimeMalformed {
+		t.Errorf("validators.TimeString faild with code=%q", code)
+	}
+}
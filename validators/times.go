@@ -0,0 +1,300 @@
+package validators
+
+import "time"
+
+// time validation error messages
+const (
+	timeBeforeMsg       = "should be before"
+	timeAfterMsg        = "should be after"
+	timeTooEarlyMsg     = "too early"
+	timeTooLateMsg      = "too late"
+	notZeroTimeMsg      = "shouldn't be zero time"
+	timeInFutureMsg     = "should be in the future"
+	timeInPastMsg       = "should be in the past"
+	durationTooShortMsg = "too short duration"
+	durationTooLongMsg  = "too long duration"
+)
+
+// time validation error codes
+const (
+	CodeTimeNotBefore    = "time.not_before"
+	CodeTimeNotAfter     = "time.not_after"
+	CodeTimeTooEarly     = "time.too_early"
+	CodeTimeTooLate      = "time.too_late"
+	CodeTimeZero         = "time.zero"
+	CodeTimeNotInFuture  = "time.not_in_future"
+	CodeTimeNotInPast    = "time.not_in_past"
+	CodeDurationTooShort = "duration.too_short"
+	CodeDurationTooLong  = "duration.too_long"
+)
+
+// Clock is the interface that defines the source of the current time.
+//
+// The validators relative to now use a Clock, so that they can be tested with a fixed time.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc is an adapter to use a function as a Clock.
+type ClockFunc func() time.Time
+
+// Now implements the Clock interface
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// now returns the current time of c, or time.Now() if c is nil.
+func now(c Clock) time.Time {
+	if c == nil {
+		return time.Now()
+	}
+	return c.Now()
+}
+
+// TimeBefore is a validator which will check whether the field Value is before field Target.
+type TimeBefore struct {
+	Value  time.Time
+	Target time.Time
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *TimeBefore) Validate() error {
+	if !v.Value.Before(v.Target) {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, timeBeforeMsg),
+			overridden: v.message != nil,
+			code:       CodeTimeNotBefore,
+			params:     map[string]interface{}{"target": v.Target},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *TimeBefore) OverrideMessage(msg string) *TimeBefore {
+	v.message = &msg
+	return v
+}
+
+// TimeAfter is a validator which will check whether the field Value is after field Target.
+type TimeAfter struct {
+	Value  time.Time
+	Target time.Time
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *TimeAfter) Validate() error {
+	if !v.Value.After(v.Target) {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.message, timeAfterMsg),
+			overridden: v.message != nil,
+			code:       CodeTimeNotAfter,
+			params:     map[string]interface{}{"target": v.Target},
+		}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *TimeAfter) OverrideMessage(msg string) *TimeAfter {
+	v.message = &msg
+	return v
+}
+
+// TimeInRange is a validator which will check whether the field Value is in range of field Min and Max, inclusive.
+type TimeInRange struct {
+	Value time.Time
+	Min   time.Time
+	Max   time.Time
+
+	tooEarlyMessage *string
+	tooLateMessage  *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *TimeInRange) Validate() error {
+	return validateTimeRange(v.Value, v.Min, v.Max, v.tooEarlyMessage, v.tooLateMessage,
+		map[string]interface{}{"min": v.Min, "max": v.Max})
+}
+
+// OverrideTooEarlyMessage overrides the error message of the too early time validation
+func (v *TimeInRange) OverrideTooEarlyMessage(msg string) *TimeInRange {
+	v.tooEarlyMessage = &msg
+	return v
+}
+
+// OverrideTooLateMessage overrides the error message of the too late time validation
+func (v *TimeInRange) OverrideTooLateMessage(msg string) *TimeInRange {
+	v.tooLateMessage = &msg
+	return v
+}
+
+// TimeFromNow is a validator which will check whether the field Value is in range of
+// now plus field Min and now plus field Max, inclusive.
+//
+// For example, a Value within the next 30 days is in range of Min 0 and Max 30 * 24 * time.Hour.
+// Now is the current time of field Clock, or time.Now() if Clock is nil.
+type TimeFromNow struct {
+	Value time.Time
+	Min   time.Duration
+	Max   time.Duration
+	Clock Clock
+
+	tooEarlyMessage *string
+	tooLateMessage  *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *TimeFromNow) Validate() error {
+	t := now(v.Clock)
+	return validateTimeRange(v.Value, t.Add(v.Min), t.Add(v.Max), v.tooEarlyMessage, v.tooLateMessage,
+		map[string]interface{}{"min": v.Min, "max": v.Max})
+}
+
+// OverrideTooEarlyMessage overrides the error message of the too early time validation
+func (v *TimeFromNow) OverrideTooEarlyMessage(msg string) *TimeFromNow {
+	v.tooEarlyMessage = &msg
+	return v
+}
+
+// OverrideTooLateMessage overrides the error message of the too late time validation
+func (v *TimeFromNow) OverrideTooLateMessage(msg string) *TimeFromNow {
+	v.tooLateMessage = &msg
+	return v
+}
+
+func validateTimeRange(value, min, max time.Time, tooEarlyMessage, tooLateMessage *string, params map[string]interface{}) error {
+	if value.Before(min) {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(tooEarlyMessage, timeTooEarlyMsg),
+			overridden: tooEarlyMessage != nil,
+			code:       CodeTimeTooEarly,
+			params:     params,
+		}
+	}
+	if value.After(max) {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(tooLateMessage, timeTooLateMsg),
+			overridden: tooLateMessage != nil,
+			code:       CodeTimeTooLate,
+			params:     params,
+		}
+	}
+	return nil
+}
+
+// TimeInFuture is a validator which will check whether the field Value is after now.
+//
+// Now is the current time of field Clock, or time.Now() if Clock is nil.
+type TimeInFuture struct {
+	Value time.Time
+	Clock Clock
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *TimeInFuture) Validate() error {
+	if !v.Value.After(now(v.Clock)) {
+		return &validationError{msg: returnDefaultStringIfNil(v.message, timeInFutureMsg), overridden: v.message != nil, code: CodeTimeNotInFuture}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *TimeInFuture) OverrideMessage(msg string) *TimeInFuture {
+	v.message = &msg
+	return v
+}
+
+// TimeInPast is a validator which will check whether the field Value is before now.
+//
+// Now is the current time of field Clock, or time.Now() if Clock is nil.
+type TimeInPast struct {
+	Value time.Time
+	Clock Clock
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *TimeInPast) Validate() error {
+	if !v.Value.Before(now(v.Clock)) {
+		return &validationError{msg: returnDefaultStringIfNil(v.message, timeInPastMsg), overridden: v.message != nil, code: CodeTimeNotInPast}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *TimeInPast) OverrideMessage(msg string) *TimeInPast {
+	v.message = &msg
+	return v
+}
+
+// NotZeroTime is a validator which will check whether the field Value isn't the zero time.
+type NotZeroTime struct {
+	Value time.Time
+
+	message *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *NotZeroTime) Validate() error {
+	if v.Value.IsZero() {
+		return &validationError{msg: returnDefaultStringIfNil(v.message, notZeroTimeMsg), overridden: v.message != nil, code: CodeTimeZero}
+	}
+	return nil
+}
+
+// OverrideMessage overrides the validation error message of current validator
+func (v *NotZeroTime) OverrideMessage(msg string) *NotZeroTime {
+	v.message = &msg
+	return v
+}
+
+// DurationInRange is a validator which will check whether the field Value is in range of field Min and Max, inclusive.
+type DurationInRange struct {
+	Value time.Duration
+	Min   time.Duration
+	Max   time.Duration
+
+	tooShortMessage *string
+	tooLongMessage  *string
+}
+
+// Validate implements the guard.Validator interface
+func (v *DurationInRange) Validate() error {
+	if v.Value < v.Min {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.tooShortMessage, durationTooShortMsg),
+			overridden: v.tooShortMessage != nil,
+			code:       CodeDurationTooShort,
+			params:     map[string]interface{}{"min": v.Min, "max": v.Max},
+		}
+	}
+	if v.Value > v.Max {
+		return &validationError{
+			msg:        returnDefaultStringIfNil(v.tooLongMessage, durationTooLongMsg),
+			overridden: v.tooLongMessage != nil,
+			code:       CodeDurationTooLong,
+			params:     map[string]interface{}{"min": v.Min, "max": v.Max},
+		}
+	}
+	return nil
+}
+
+// OverrideTooShortMessage overrides the error message of the too short duration validation
+func (v *DurationInRange) OverrideTooShortMessage(msg string) *DurationInRange {
+	v.tooShortMessage = &msg
+	return v
+}
+
+// OverrideTooLongMessage overrides the error message of the too long duration validation
+func (v *DurationInRange) OverrideTooLongMessage(msg string) *DurationInRange {
+	v.tooLongMessage = &msg
+	return v
+}
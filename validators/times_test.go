@@ -0,0 +1,112 @@
+package validators_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nauyey/guard/validators"
+)
+
+var (
+	testNow   = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	testClock = validators.ClockFunc(func() time.Time { return testNow })
+)
+
+func TestTimeBefore(t *testing.T) {
+	if err := (&validators.TimeBefore{Value: testNow, Target: testNow.Add(time.Second)}).Validate(); err != nil {
+		t.Errorf("validators.TimeBefore faild")
+	}
+	if err := (&validators.TimeBefore{Value: testNow, Target: testNow}).Validate(); err == nil {
+		t.Errorf("validators.TimeBefore faild")
+	}
+}
+
+func TestTimeAfter(t *testing.T) {
+	if err := (&validators.TimeAfter{Value: testNow.Add(time.Second), Target: testNow}).Validate(); err != nil {
+		t.Errorf("validators.TimeAfter faild")
+	}
+	if err := (&validators.TimeAfter{Value: testNow, Target: testNow}).Validate(); err == nil {
+		t.Errorf("validators.TimeAfter faild")
+	}
+}
+
+func TestTimeInRange(t *testing.T) {
+	min, max := testNow, testNow.Add(time.Hour)
+
+	if err := (&validators.TimeInRange{Value: min, Min: min, Max: max}).Validate(); err != nil {
+		t.Errorf("validators.TimeInRange faild")
+	}
+	if err := (&validators.TimeInRange{Value: max, Min: min, Max: max}).Validate(); err != nil {
+		t.Errorf("validators.TimeInRange faild")
+	}
+	if code := codeOf((&validators.TimeInRange{Value: min.Add(-1), Min: min, Max: max}).Validate()); code != validators.CodeTimeTooEarly {
+		t.Errorf("validators.TimeInRange faild with code=%q", code)
+	}
+	if code := codeOf((&validators.TimeInRange{Value: max.Add(1), Min: min, Max: max}).Validate()); code != validators.CodeTimeTooLate {
+		t.Errorf("validators.TimeInRange faild with code=%q", code)
+	}
+
+	// test override error message
+	err := (&validators.TimeInRange{Value: max.Add(1), Min: min, Max: max}).OverrideTooLateMessage("override error message").Validate()
+	if err == nil || err.Error() != "override error message" {
+		t.Errorf("validators.TimeInRange faild")
+	}
+}
+
+func TestTimeFromNow(t *testing.T) {
+	within30Days := func(value time.Time) *validators.TimeFromNow {
+		return &validators.TimeFromNow{Value: value, Min: 0, Max: 30 * 24 * time.Hour, Clock: testClock}
+	}
+
+	if err := within30Days(testNow.Add(24 * time.Hour)).Validate(); err != nil {
+		t.Errorf("validators.TimeFromNow faild")
+	}
+	if code := codeOf(within30Days(testNow.Add(-time.Second)).Validate()); code != validators.CodeTimeTooEarly {
+		t.Errorf("validators.TimeFromNow faild with code=%q", code)
+	}
+	if code := codeOf(within30Days(testNow.Add(31 * 24 * time.Hour)).Validate()); code != validators.CodeTimeTooLate {
+		t.Errorf("validators.TimeFromNow faild with code=%q", code)
+	}
+	if err := (&validators.TimeFromNow{Value: time.Now(), Min: -time.Minute, Max: time.Minute}).Validate(); err != nil {
+		t.Errorf("validators.TimeFromNow faild with nil Clock")
+	}
+}
+
+func TestTimeInFuture(t *testing.T) {
+	if err := (&validators.TimeInFuture{Value: testNow.Add(time.Second), Clock: testClock}).Validate(); err != nil {
+		t.Errorf("validators.TimeInFuture faild")
+	}
+	if err := (&validators.TimeInFuture{Value: testNow, Clock: testClock}).Validate(); err == nil {
+		t.Errorf("validators.TimeInFuture faild")
+	}
+}
+
+func TestTimeInPast(t *testing.T) {
+	if err := (&validators.TimeInPast{Value: testNow.Add(-time.Second), Clock: testClock}).Validate(); err != nil {
+		t.Errorf("validators.TimeInPast faild")
+	}
+	if err := (&validators.TimeInPast{Value: testNow, Clock: testClock}).Validate(); err == nil {
+		t.Errorf("validators.TimeInPast faild")
+	}
+}
+
+func TestNotZeroTime(t *testing.T) {
+	if err := (&validators.NotZeroTime{Value: testNow}).Validate(); err != nil {
+		t.Errorf("validators.NotZeroTime faild")
+	}
+	if err := (&validators.NotZeroTime{}).Validate(); err == nil {
+		t.Errorf("validators.NotZeroTime faild")
+	}
+}
+
+func TestDurationInRange(t *testing.T) {
+	if err := (&validators.DurationInRange{Value: time.Second, Min: time.Second, Max: 5 * time.Minute}).Validate(); err != nil {
+		t.Errorf("validators.DurationInRange faild")
+	}
+	if code := codeOf((&validators.DurationInRange{Value: time.Millisecond, Min: time.Second, Max: 5 * time.Minute}).Validate()); code != validators.CodeDurationTooShort {
+		t.Errorf("validators.DurationInRange faild with code=%q", code)
+	}
+	if code := codeOf((&validators.DurationInRange{Value: time.Hour, Min: time.Second, Max: 5 * time.Minute}).Validate()); code != validators.CodeDurationTooLong {
+		t.Errorf("validators.DurationInRange faild with code=%q", code)
+	}
+}
@@ -0,0 +1,194 @@
+package validators
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// isBlankRune reports whether r is an Unicode whitespace or a zero-width character.
+func isBlankRune(r rune) bool {
+	return unicode.IsSpace(r) || isZeroWidth(r)
+}
+
+// isZeroWidth reports whether r is a zero-width character, like ZERO WIDTH SPACE
+// and ZERO WIDTH NO-BREAK SPACE (BOM).
+func isZeroWidth(r rune) bool {
+	switch r {
+	case '\u180E', '\u200B', '\u200C', '\u200D', '\u2060', '\uFEFF':
+		return true
+	}
+	return false
+}
+
+// graphemes returns the number of the grapheme clusters of s.
+//
+// It implements the most of the extended grapheme cluster rules of Unicode Standard Annex #29:
+// CR LF, Hangul syllable sequences, extending and spacing marks, emoji ZWJ sequences and
+// regional indicator pairs (flags).
+func graphemes(s string) int {
+	n := 0
+	forEachGrapheme(s, func(string) { n++ })
+	return n
+}
+
+// displayWidth returns the width of s in a monospace font, counting East Asian wide and
+// fullwidth characters and emojis as 2 columns, and zero-width and control characters as 0.
+func displayWidth(s string) int {
+	width := 0
+	forEachGrapheme(s, func(cluster string) {
+		width += clusterWidth(cluster)
+	})
+	return width
+}
+
+func forEachGrapheme(s string, f func(cluster string)) {
+	start := 0
+	prev := utf8.RuneError
+	regionalIndicators := 0
+	pictographicZWJ := false
+
+	for i, r := range s {
+		if i > 0 && isGraphemeBreak(prev, r, regionalIndicators, pictographicZWJ) {
+			f(s[start:i])
+			start = i
+			regionalIndicators = 0
+			pictographicZWJ = false
+		}
+
+		if isRegionalIndicator(r) {
+			regionalIndicators++
+		}
+		if isPictographic(r) {
+			pictographicZWJ = false
+		} else if r == '\u200D' && (isPictographic(prev) || isExtend(prev)) {
+			pictographicZWJ = true
+		}
+		prev = r
+	}
+	if start < len(s) {
+		f(s[start:])
+	}
+}
+
+func isGraphemeBreak(prev, r rune, regionalIndicators int, pictographicZWJ bool) bool {
+	switch {
+	case prev == '\r' && r == '\n':
+		return false
+	case isControl(prev) || isControl(r):
+		return true
+	case isHangulJoin(prev, r):
+		return false
+	case isExtend(r) || r == '\u200D' || unicode.Is(unicode.Mc, r):
+		return false
+	case pictographicZWJ && prev == '\u200D' && isPictographic(r):
+		return false
+	case isRegionalIndicator(prev) && isRegionalIndicator(r):
+		return regionalIndicators%2 == 0
+	}
+	return true
+}
+
+func isControl(r rune) bool {
+	return unicode.IsControl(r) || r == '\u2028' || r == '\u2029'
+}
+
+func isExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me) ||
+		r >= 0xFE00 && r <= 0xFE0F || // variation selectors
+		r >= 0x1F3FB && r <= 0x1F3FF || // emoji modifiers
+		r >= 0xE0020 && r <= 0xE007F || // tags
+		r >= 0xE0100 && r <= 0xE01EF // variation selectors supplement
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+func isPictographic(r rune) bool {
+	return r >= 0x1F000 && r <= 0x1FAFF && !isRegionalIndicator(r) && !isExtend(r) ||
+		r >= 0x2600 && r <= 0x27BF ||
+		r == 0x00A9 || r == 0x00AE || r == 0x203C || r == 0x2049 || r == 0x2122
+}
+
+// Hangul syllable types
+const (
+	hangulL = iota + 1
+	hangulV
+	hangulT
+	hangulLV
+	hangulLVT
+)
+
+func hangulType(r rune) int {
+	switch {
+	case r >= 0x1100 && r <= 0x115F, r >= 0xA960 && r <= 0xA97C:
+		return hangulL
+	case r >= 0x1160 && r <= 0x11A7, r >= 0xD7B0 && r <= 0xD7C6:
+		return hangulV
+	case r >= 0x11A8 && r <= 0x11FF, r >= 0xD7CB && r <= 0xD7FB:
+		return hangulT
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return hangulLV
+		}
+		return hangulLVT
+	}
+	return 0
+}
+
+func isHangulJoin(prev, r rune) bool {
+	p, c := hangulType(prev), hangulType(r)
+	switch p {
+	case hangulL:
+		return c == hangulL || c == hangulV || c == hangulLV || c == hangulLVT
+	case hangulLV, hangulV:
+		return c == hangulV || c == hangulT
+	case hangulLVT, hangulT:
+		return c == hangulT
+	}
+	return false
+}
+
+func clusterWidth(cluster string) int {
+	r, _ := utf8.DecodeRuneInString(cluster)
+	switch {
+	case isControl(r), isZeroWidth(r), isExtend(r), unicode.Is(unicode.Cf, r):
+		return 0
+	case isWide(r), isRegionalIndicator(r):
+		return 2
+	}
+	for _, r := range cluster {
+		if r == '\uFE0F' { // emoji presentation selector
+			return 2
+		}
+	}
+	return 1
+}
+
+// wideRanges are the East Asian wide (W) and fullwidth (F) ranges of Unicode.
+var wideRanges = []struct{ lo, hi rune }{
+	{0x1100, 0x115F}, {0x231A, 0x231B}, {0x2329, 0x232A}, {0x23E9, 0x23EC}, {0x23F0, 0x23F0},
+	{0x23F3, 0x23F3}, {0x25FD, 0x25FE}, {0x2614, 0x2615}, {0x2648, 0x2653}, {0x267F, 0x267F},
+	{0x2693, 0x2693}, {0x26A1, 0x26A1}, {0x26AA, 0x26AB}, {0x26BD, 0x26BE}, {0x26C4, 0x26C5},
+	{0x26CE, 0x26CE}, {0x26D4, 0x26D4}, {0x26EA, 0x26EA}, {0x26F2, 0x26F3}, {0x26F5, 0x26F5},
+	{0x26FA, 0x26FA}, {0x26FD, 0x26FD}, {0x2705, 0x2705}, {0x270A, 0x270B}, {0x2728, 0x2728},
+	{0x274C, 0x274C}, {0x274E, 0x274E}, {0x2753, 0x2755}, {0x2757, 0x2757}, {0x2795, 0x2797},
+	{0x27B0, 0x27B0}, {0x27BF, 0x27BF}, {0x2B1B, 0x2B1C}, {0x2B50, 0x2B50}, {0x2B55, 0x2B55},
+	{0x2E80, 0x303E}, {0x3041, 0x33FF}, {0x3400, 0x4DBF}, {0x4E00, 0x9FFF}, {0xA000, 0xA4CF},
+	{0xA960, 0xA97F}, {0xAC00, 0xD7A3}, {0xF900, 0xFAFF}, {0xFE10, 0xFE19}, {0xFE30, 0xFE6F},
+	{0xFF00, 0xFF60}, {0xFFE0, 0xFFE6}, {0x16FE0, 0x16FE4}, {0x17000, 0x18AFF}, {0x1B000, 0x1B2FF},
+	{0x1F004, 0x1F004}, {0x1F0CF, 0x1F0CF}, {0x1F18E, 0x1F18E}, {0x1F191, 0x1F19A}, {0x1F200, 0x1F202},
+	{0x1F210, 0x1F23B}, {0x1F240, 0x1F248}, {0x1F250, 0x1F251}, {0x1F260, 0x1F265}, {0x1F300, 0x1F320},
+	{0x1F32D, 0x1F335}, {0x1F337, 0x1F37C}, {0x1F37E, 0x1F393}, {0x1F3A0, 0x1F3CA}, {0x1F3CF, 0x1F3D3},
+	{0x1F3E0, 0x1F3F0}, {0x1F3F4, 0x1F3F4}, {0x1F3F8, 0x1F43E}, {0x1F440, 0x1F440}, {0x1F442, 0x1F4FC},
+	{0x1F4FF, 0x1F53D}, {0x1F54B, 0x1F54E}, {0x1F550, 0x1F567}, {0x1F57A, 0x1F57A}, {0x1F595, 0x1F596},
+	{0x1F5A4, 0x1F5A4}, {0x1F5FB, 0x1F64F}, {0x1F680, 0x1F6C5}, {0x1F6CC, 0x1F6CC}, {0x1F6D0, 0x1F6D2},
+	{0x1F6D5, 0x1F6D7}, {0x1F6EB, 0x1F6EC}, {0x1F6F4, 0x1F6FC}, {0x1F7E0, 0x1F7EB}, {0x1F90C, 0x1F93A},
+	{0x1F93C, 0x1F945}, {0x1F947, 0x1F9FF}, {0x1FA70, 0x1FAFF}, {0x20000, 0x2FFFD}, {0x30000, 0x3FFFD},
+}
+
+func isWide(r rune) bool {
+	i := sort.Search(len(wideRanges), func(i int) bool { return wideRanges[i].hi >= r })
+	return i < len(wideRanges) && wideRanges[i].lo <= r
+}